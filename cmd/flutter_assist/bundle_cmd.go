@@ -0,0 +1,180 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/bundle"
+	"github.com/burak/flutter_assist/internal/prompt"
+)
+
+// runExport, template_util yapılandırmasını manifest ve checksum ile arşive yazar
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	name := fs.String("name", "", "Arşivin ismi")
+	version := fs.String("version", "", "Arşivin sürümü")
+	description := fs.String("description", "", "Arşivin açıklaması")
+	keyPath := fs.String("sign", "", "Manifesti imzalamak için özel anahtar dosyası")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "export <arsiv.tar.gz|arsiv.zip> [-name isim] [-version 1.0.0] [-description metin] [-sign anahtar.key]"); err != nil {
+		return err
+	}
+
+	var key ed25519.PrivateKey
+	if *keyPath != "" {
+		if key, err = bundle.ReadPrivateKey(*keyPath); err != nil {
+			return err
+		}
+	}
+
+	manifest, sum, err := bundle.Export(positional[0], bundle.Manifest{Name: *name, Version: *version, Description: *description}, key)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("📦 %d dosya dışa aktarıldı: %s\n", len(manifest.Files), positional[0])
+	fmt.Printf("🔒 sha256: %s\n", sum)
+	if key != nil {
+		fmt.Printf("🔏 Manifest imzalandı (anahtar %s)\n", bundle.KeyID(key.Public().(ed25519.PublicKey)))
+	}
+	return nil
+}
+
+// runImport, bir arşivi önizleme ve onay ile mevcut template_util yapılandırmasına birleştirir
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	strategy := fs.String("on-conflict", bundle.ConflictSkip, "Çakışmalarda yapılacak işlem (skip, overwrite, rename)")
+	dryRun := fs.Bool("dry-run", false, "Sadece önizleme göster, değişiklik yapma")
+	yes := fs.Bool("y", false, "Onay sormadan uygula")
+	allowUnsigned := fs.Bool("allow-unsigned", false, "İmzasız veya güvenilmeyen anahtarla imzalanmış arşivleri kabul et")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "import <arsiv> [-on-conflict skip|overwrite|rename] [-dry-run] [-y] [-allow-unsigned]"); err != nil {
+		return err
+	}
+	archivePath := positional[0]
+
+	checked, err := bundle.VerifyChecksumFile(archivePath)
+	if err != nil {
+		return err
+	}
+
+	b, err := bundle.Read(archivePath)
+	if err != nil {
+		return err
+	}
+
+	return applyBundle(b, checked, *strategy, *dryRun, *yes, *allowUnsigned)
+}
+
+// applyBundle, arşivin imzasını doğrular, birleştirme planını gösterir ve onay alındıktan sonra uygular
+func applyBundle(b *bundle.Bundle, checked bool, strategy string, dryRun bool, yes bool, allowUnsigned bool) error {
+	if err := checkSignature(b, allowUnsigned); err != nil {
+		return err
+	}
+
+	utilDir, err := bundle.UtilDir()
+	if err != nil {
+		return err
+	}
+	plan, err := bundle.NewPlan(b, utilDir, strategy)
+	if err != nil {
+		return err
+	}
+
+	printManifest(b.Manifest, checked)
+	printPlan(plan)
+
+	pending := plan.Count(bundle.ActionAdd) + plan.Count(bundle.ActionOverwrite) + plan.Count(bundle.ActionRename)
+	if dryRun || pending == 0 {
+		return nil
+	}
+
+	if !yes {
+		fmt.Print("ℹ️ Değişiklikler uygulansın mı? (e/h): ")
+		answer, _ := prompt.ReadLine()
+		if strings.ToLower(answer) != "e" {
+			fmt.Println("ℹ️ İçe aktarma iptal edildi")
+			return nil
+		}
+	}
+
+	if err := plan.Apply(utilDir); err != nil {
+		return err
+	}
+	fmt.Println("✅ Arşiv başarıyla içe aktarıldı!")
+	return nil
+}
+
+// checkSignature, arşivin imzasını güvenilen anahtarlar ile doğrular.
+// İmzasız veya güvenilmeyen arşivler sadece allowUnsigned ile kabul edilir; geçersiz imza her zaman reddedilir.
+func checkSignature(b *bundle.Bundle, allowUnsigned bool) error {
+	trusted, err := bundle.LoadTrustedKeys()
+	if err != nil {
+		return err
+	}
+
+	signer, err := b.VerifySignature(trusted)
+	switch {
+	case err == nil:
+		fmt.Printf("🔏 İmza doğrulandı: %s\n", signer)
+		return nil
+	case errors.Is(err, bundle.ErrUnsigned) || errors.Is(err, bundle.ErrUntrusted):
+		if !allowUnsigned {
+			return fmt.Errorf("%v, yine de kurmak için -allow-unsigned kullanın", err)
+		}
+		fmt.Printf("⚠️ %v, -allow-unsigned ile devam ediliyor\n", err)
+		return nil
+	default:
+		return err
+	}
+}
+
+// printManifest, arşivin bilgilerini gösterir
+func printManifest(manifest bundle.Manifest, checked bool) {
+	title := manifest.Name
+	if title == "" {
+		title = "isimsiz"
+	}
+	if manifest.Version != "" {
+		title += " " + manifest.Version
+	}
+	fmt.Printf("📦 %s (%d dosya, %s)\n", title, len(manifest.Files), manifest.CreatedAt)
+	if checked {
+		fmt.Println("🔒 Arşiv checksum'ı doğrulandı")
+	}
+}
+
+// printPlan, içe aktarma planını değişiklik türüne göre gösterir
+func printPlan(plan *bundle.Plan) {
+	symbols := map[string]string{
+		bundle.ActionAdd:       "+",
+		bundle.ActionOverwrite: "~",
+		bundle.ActionRename:    ">",
+		bundle.ActionSkip:      "!",
+		bundle.ActionSame:      "=",
+	}
+
+	for _, c := range plan.Changes {
+		label := c.Path
+		if c.Name != "" {
+			label += ": " + c.Name
+		}
+		if c.Target != "" {
+			label += " -> " + c.Target
+		}
+		fmt.Printf("  %s %-18s %s\n", symbols[c.Action], c.Action, label)
+	}
+
+	fmt.Printf("📊 %d eklenecek, %d üzerine yazılacak, %d yeniden adlandırılacak, %d atlanacak, %d aynı\n",
+		plan.Count(bundle.ActionAdd), plan.Count(bundle.ActionOverwrite), plan.Count(bundle.ActionRename),
+		plan.Count(bundle.ActionSkip), plan.Count(bundle.ActionSame))
+}
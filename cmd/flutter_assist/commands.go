@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// commands, flag'lerden sonra ilk argüman olarak verilen alt komutlar
+var commands = map[string]func(args []string) error{
+	"template": runTemplateCommand,
+	"export":   runExport,
+	"import":   runImport,
+	"source":   runSourceCommand,
+	"search":   runSearch,
+	"install":  runInstall,
+	"publish":  runPublish,
+	"registry": runRegistryCommand,
+	"keys":     runKeysCommand,
+	"gen":      runGenCommand,
+	"locale":   runLocaleCommand,
+	"firebase": runFirebaseCommand,
+}
+
+// parseArgs, alt komut flag'lerini pozisyonel argümanlar ile karışık sırada parse eder
+// ve pozisyonel argümanları döndürür. Örn: "show app.dart -types FIREBASE"
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// splitList, virgülle ayrılmış bir değeri boş elemanları atarak listeye çevirir
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// requireArgs, pozisyonel argüman sayısını kontrol eder
+func requireArgs(args []string, count int, usage string) error {
+	if len(args) != count {
+		return fmt.Errorf("kullanım: flutter_assist %s", usage)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/burak/flutter_assist/internal/firebase"
+	"github.com/burak/flutter_assist/internal/pubspec"
+)
+
+// runFirebaseCommand, projenin Firebase yapılandırması ile ilgili alt komutları çalıştırır
+func runFirebaseCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("firebase alt komutu belirtilmedi (check)")
+	}
+
+	switch args[0] {
+	case "check":
+		return runFirebaseCheck(args[1:])
+	default:
+		return fmt.Errorf("bilinmeyen firebase komutu: %s", args[0])
+	}
+}
+
+// runFirebaseCheck, firebase_options.dart dosyasının hala taslak olup olmadığını kontrol eder.
+// -strict verilirse taslak kullanılıyorsa hata döner
+func runFirebaseCheck(args []string) error {
+	fs := flag.NewFlagSet("firebase check", flag.ExitOnError)
+	strict := fs.Bool("strict", false, "Taslak yapılandırma kullanılıyorsa hata ile çık")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 0, "firebase check [-strict]"); err != nil {
+		return err
+	}
+
+	root, err := pubspec.FindRoot(".")
+	if err != nil {
+		return err
+	}
+	status, err := firebase.Check(root)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !status.Used:
+		fmt.Println("ℹ️ Projede Firebase kullanılmıyor")
+	case !status.Exists:
+		fmt.Printf("⚠️ %s bulunamadı, flutterfire configure çalıştırın\n", firebase.OptionsFile)
+	case !status.Stub:
+		fmt.Printf("✅ %s flutterfire configure ile oluşturulmuş\n", firebase.OptionsFile)
+	default:
+		printFirebaseStub(status)
+	}
+
+	if *strict && status.Used && (!status.Exists || status.Stub) {
+		return fmt.Errorf("Firebase yapılandırması tamamlanmamış")
+	}
+	return nil
+}
+
+// printFirebaseStub, taslak yapılandırma kullanıldığı uyarısını ve değeri girilmemiş alanları yazdırır
+func printFirebaseStub(status *firebase.Status) {
+	fmt.Printf("⚠️ %s flutter_assist taslağı, gerçek yapılandırma için flutterfire configure çalıştırın\n", firebase.OptionsFile)
+	for _, field := range status.Placeholders {
+		fmt.Printf("  ⚠️ %s değeri girilmemiş (%s)\n", field, firebase.Placeholder)
+	}
+}
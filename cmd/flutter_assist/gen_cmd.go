@@ -0,0 +1,394 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/burak/flutter_assist/internal/generator"
+	"github.com/burak/flutter_assist/internal/pubspec"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// runGenCommand, mevcut bir proje içinde kod üreten "gen" alt komutlarını çalıştırır
+func runGenCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("gen alt komutu belirtilmedi (feature, bloc, cubit, model, routes, assets, flavors, di)")
+	}
+
+	switch args[0] {
+	case "feature":
+		return runGenFeature(args[1:])
+	case "bloc", "cubit":
+		return runGenBloc(args[0], args[1:])
+	case "model":
+		return runGenModel(args[1:])
+	case "routes":
+		return runGenRoutes(args[1:])
+	case "assets":
+		return runGenAssets(args[1:])
+	case "flavors":
+		return runGenFlavors(args[1:])
+	case "di":
+		return runGenDependencies(args[1:])
+	default:
+		return fmt.Errorf("bilinmeyen gen komutu: %s", args[0])
+	}
+}
+
+// genFlags, generator komutlarının ortak flag'lerini tanımlar
+func genFlags(fs *flag.FlagSet) *generator.Options {
+	vars := varFlags{}
+	opts := &generator.Options{Vars: vars}
+	fs.Func("types", "Projenin kayıtlı type'ları yerine kullanılacak type'lar (virgülle ayrılmış)", func(value string) error {
+		opts.Types = splitList(value)
+		return nil
+	})
+	fs.Var(vars, "var", "Template değişkeni (key=value), birden fazla kez verilebilir")
+	fs.BoolVar(&opts.Force, "force", false, "Mevcut dosyaların üzerine yaz")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "Dosyaları yazmadan sadece listele")
+	return opts
+}
+
+// runGenFeature, projenin lib/feature/<isim>/ klasörüne feature modülü üretir
+func runGenFeature(args []string) error {
+	fs := flag.NewFlagSet("gen feature", flag.ExitOnError)
+	opts := genFlags(fs)
+	fs.BoolVar(&opts.NoTests, "no-test", false, "Companion test dosyalarını üretme")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "gen feature <isim> [-types A,B] [-var KEY=value] [-force] [-dry-run] [-no-test]"); err != nil {
+		return err
+	}
+	// View, view model ve state flutter_bloc ve equatable ile yazılır
+	opts.Requires = blocPackages
+
+	files, err := generator.Generate("feature", positional[0], *opts)
+	if err != nil {
+		return err
+	}
+	printGenerated(files, opts.DryRun)
+	if !opts.DryRun {
+		fmt.Printf("✅ %s feature'ı oluşturuldu\n", positional[0])
+	}
+	if !opts.NoTests {
+		suggestBlocTest()
+	}
+	return nil
+}
+
+// blocPackages, bloc ve cubit generator'larının ürettiği kodun ihtiyaç duyduğu paketler
+var blocPackages = []string{"flutter_bloc", "equatable"}
+
+// runGenBloc, bloc (bloc, event, state) veya cubit (cubit, state) dosyalarını üretir.
+// Dosyalar lib/feature/<feature>/<tür>/ altına yazılır, feature verilmezse isim kullanılır.
+func runGenBloc(kind string, args []string) error {
+	fs := flag.NewFlagSet("gen "+kind, flag.ExitOnError)
+	opts := genFlags(fs)
+	feature := fs.String("feature", "", "Dosyaların yazılacağı feature (varsayılan: isim)")
+	fs.BoolVar(&opts.NoTests, "no-test", false, "Companion test dosyalarını üretme")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "gen "+kind+" <isim> [-feature isim] [-types A,B] [-var KEY=value] [-force] [-dry-run] [-no-test]"); err != nil {
+		return err
+	}
+	if *feature != "" {
+		if err := generator.CheckName(*feature); err != nil {
+			return err
+		}
+		opts.Vars[generator.FeatureKey] = *feature
+	}
+	opts.Requires = blocPackages
+
+	files, err := generator.Generate(kind, positional[0], *opts)
+	if err != nil {
+		return err
+	}
+	printGenerated(files, opts.DryRun)
+	if !opts.DryRun {
+		fmt.Printf("✅ %s için %s dosyaları oluşturuldu\n", positional[0], kind)
+	}
+	if !opts.NoTests {
+		suggestBlocTest()
+	}
+	return nil
+}
+
+// suggestBlocTest, projede bloc_test yoksa testlerin blocTest ile üretilmesi için paketi önerir
+func suggestBlocTest() {
+	root, err := pubspec.FindRoot(".")
+	if err != nil {
+		return
+	}
+	packages, err := pubspec.Packages(filepath.Join(root, pubspec.FileName))
+	if err != nil {
+		return
+	}
+	for _, pkg := range packages {
+		if pkg == "bloc_test" {
+			return
+		}
+	}
+	fmt.Println("ℹ️ Testler flutter_test ile üretildi, blocTest kullanmak için: flutter pub add --dev bloc_test")
+}
+
+// runGenModel, örnek JSON dosyasından model sınıflarını üretir
+func runGenModel(args []string) error {
+	fs := flag.NewFlagSet("gen model", flag.ExitOnError)
+	opts := genFlags(fs)
+	from := fs.String("from", "", "Model sınıflarının çıkarılacağı örnek JSON dosyası")
+	style := fs.String("style", generator.ModelStyleManual, "Serileştirme stili (manual, json_serializable veya generators/model altındaki başka bir klasör)")
+	feature := fs.String("feature", "", "Dosyaların yazılacağı feature (varsayılan: isim)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	usage := "gen model <İsim> -from ornek.json [-style manual|json_serializable] [-feature isim] [-force] [-dry-run]"
+	if err := requireArgs(positional, 1, usage); err != nil {
+		return err
+	}
+	if *from == "" {
+		return fmt.Errorf("kullanım: flutter_assist %s", usage)
+	}
+	if *feature != "" {
+		if err := generator.CheckName(*feature); err != nil {
+			return err
+		}
+		opts.Vars[generator.FeatureKey] = *feature
+	}
+
+	files, err := generator.GenerateModels(positional[0], *from, *style, *opts)
+	if err != nil {
+		return err
+	}
+	printGenerated(files, opts.DryRun)
+	if !opts.DryRun {
+		fmt.Printf("✅ %s için %d model sınıfı oluşturuldu\n", positional[0], len(files))
+		if *style == generator.ModelStyleJSONSerializable {
+			fmt.Println("ℹ️ .g.dart dosyaları için: dart run build_runner build")
+		}
+	}
+	return nil
+}
+
+// runGenRoutes, lib/ altındaki sayfaları tarayıp route tablosunu yeniden üretir
+func runGenRoutes(args []string) error {
+	fs := flag.NewFlagSet("gen routes", flag.ExitOnError)
+	opts := genFlags(fs)
+	router := fs.String("router", "", "Kullanılacak router (go_router, auto_route); verilmezse projenin type'larından seçilir")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 0, "gen routes [-router go_router|auto_route] [-types A,B] [-force] [-dry-run]"); err != nil {
+		return err
+	}
+
+	result, err := generator.GenerateRoutes(*router, *opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("ℹ️ %s için %d sayfa bulundu:\n", result.Router, len(result.Routes))
+	for _, route := range result.Routes {
+		fmt.Printf("  🧭 %-24s %s (%s)\n", route.Path, route.Class, route.File)
+		if result.Router == generator.RouterAutoRoute && !route.RoutePage {
+			fmt.Printf("  ⚠️ %s @RoutePage() ile işaretlenmemiş\n", route.Class)
+		}
+	}
+
+	if len(result.Changed) == 0 {
+		fmt.Println("✅ Route dosyası güncel, değişiklik yok")
+		return nil
+	}
+	for _, file := range result.Changed {
+		if opts.DryRun {
+			fmt.Printf("  📝 %s (dry-run)\n", file)
+		} else {
+			fmt.Printf("  📄 %s\n", file)
+		}
+	}
+	if !opts.DryRun {
+		fmt.Println("✅ Route dosyası güncellendi")
+	}
+	return nil
+}
+
+// runGenAssets, assets/ klasörünü tarayıp asset sabitlerini yeniden üretir ve pubspec.yaml'a kaydeder
+func runGenAssets(args []string) error {
+	fs := flag.NewFlagSet("gen assets", flag.ExitOnError)
+	opts := genFlags(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 0, "gen assets [-types A,B] [-force] [-dry-run]"); err != nil {
+		return err
+	}
+
+	result, err := generator.GenerateAssets(*opts)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[string]int)
+	for _, asset := range result.Assets {
+		counts[asset.Category]++
+	}
+	fmt.Printf("ℹ️ %d asset bulundu: %d görsel, %d ikon, %d lottie, %d font\n", len(result.Assets),
+		counts[generator.AssetImages], counts[generator.AssetIcons], counts[generator.AssetLottie], counts[generator.AssetFonts])
+	for _, file := range result.Skipped {
+		fmt.Printf("  ⏭️ %s (kategori belirlenemedi)\n", file)
+	}
+
+	for _, file := range result.Changed {
+		if opts.DryRun {
+			fmt.Printf("  📝 %s (dry-run)\n", file)
+		} else {
+			fmt.Printf("  📄 %s\n", file)
+		}
+	}
+	// Dry-run'da pubspec.yaml değişiklikleri yazılmadan listelenir
+	added, removed, updated := "eklendi", "silindi", "güncellendi"
+	if opts.DryRun {
+		added, removed, updated = "eklenecek (dry-run)", "silinecek (dry-run)", "güncellenecek (dry-run)"
+	}
+	for _, dir := range result.Registered {
+		fmt.Printf("  ➕ %s pubspec.yaml'a %s\n", dir, added)
+	}
+	for _, dir := range result.Removed {
+		fmt.Printf("  ➖ %s pubspec.yaml'dan %s\n", dir, removed)
+	}
+	for _, family := range result.Fonts {
+		fmt.Printf("  🔤 %s font ailesi pubspec.yaml'da %s\n", family, updated)
+	}
+	for _, family := range result.RemovedFonts {
+		fmt.Printf("  ➖ %s font ailesi pubspec.yaml'dan %s\n", family, removed)
+	}
+
+	if opts.DryRun {
+		return nil
+	}
+	if len(result.Changed)+len(result.Registered)+len(result.Removed)+len(result.Fonts)+len(result.RemovedFonts) == 0 {
+		fmt.Println("✅ Asset sabitleri güncel, değişiklik yok")
+		return nil
+	}
+	fmt.Println("✅ Asset sabitleri güncellendi")
+	return nil
+}
+
+// runGenFlavors, flavors dosyasından flavor giriş noktalarını, .env dosyalarını ve ortam sınıfını üretir
+func runGenFlavors(args []string) error {
+	fs := flag.NewFlagSet("gen flavors", flag.ExitOnError)
+	opts := genFlags(fs)
+	from := fs.String("from", "", "Flavor'ları tanımlayan dosya (varsayılan: proje kökündeki flavors.json)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 0, "gen flavors [-from flavors.json] [-var KEY=value] [-force] [-dry-run]"); err != nil {
+		return err
+	}
+
+	result, err := generator.GenerateFlavors(*from, *opts)
+	if err != nil {
+		return err
+	}
+	printFlavors(result, opts.DryRun)
+	return nil
+}
+
+// printFlavors, flavor generator'ının değiştirdiği dosyaları ve çalıştırma komutunu yazdırır
+func printFlavors(result *generator.FlavorsResult, dryRun bool) {
+	for _, file := range result.Changed {
+		if dryRun {
+			fmt.Printf("  📝 %s (dry-run)\n", file)
+		} else {
+			fmt.Printf("  📄 %s\n", file)
+		}
+	}
+	for _, file := range result.Removed {
+		if dryRun {
+			fmt.Printf("  🗑️ %s silinecek (dry-run)\n", file)
+		} else {
+			fmt.Printf("  🗑️ %s silindi\n", file)
+		}
+	}
+	if dryRun {
+		return
+	}
+
+	if len(result.Changed)+len(result.Removed) == 0 {
+		fmt.Println("✅ Flavor dosyaları güncel, değişiklik yok")
+	} else {
+		fmt.Printf("✅ %d flavor için dosyalar oluşturuldu\n", len(result.Flavors))
+	}
+	for _, flavor := range result.Flavors {
+		fmt.Printf("  ▶️ flutter run -t lib/main_%s.dart --dart-define-from-file=.env.%s\n", flavor.Name, flavor.Name)
+	}
+}
+
+// runGenDependencies, servis ve repository sınıflarını tarayıp get_it kayıt dosyasını günceller
+func runGenDependencies(args []string) error {
+	fs := flag.NewFlagSet("gen di", flag.ExitOnError)
+	opts := genFlags(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 0, "gen di [-types A,B] [-force] [-dry-run]"); err != nil {
+		return err
+	}
+
+	result, err := generator.GenerateDependencies(*opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("ℹ️ %d sınıf kaydedildi:\n", len(result.Dependencies))
+	for _, dependency := range result.Dependencies {
+		fmt.Printf("  💉 %-32s %s (%s, %s)\n", dependency.Type, dependency.Class, dependency.Lifetime, dependency.File)
+	}
+	var skipped []string
+	for class := range result.Skipped {
+		skipped = append(skipped, class)
+	}
+	sort.Strings(skipped)
+	for _, class := range skipped {
+		fmt.Printf("  ⚠️ %s atlandı: %s\n", class, result.Skipped[class])
+	}
+
+	if len(result.Changed) == 0 {
+		fmt.Println("✅ Bağımlılık kayıtları güncel, değişiklik yok")
+		return nil
+	}
+	for _, file := range result.Changed {
+		if opts.DryRun {
+			fmt.Printf("  📝 %s (dry-run)\n", file)
+		} else {
+			fmt.Printf("  📄 %s\n", file)
+		}
+	}
+	if !opts.DryRun {
+		fmt.Println("✅ Bağımlılık kayıtları güncellendi")
+	}
+	return nil
+}
+
+// printGenerated, generator'ın yazdığı dosyaları listeler
+func printGenerated(files []template.RenderedFile, dryRun bool) {
+	for _, file := range files {
+		if dryRun {
+			fmt.Printf("  📝 %s (dry-run)\n", filepath.ToSlash(file.Path))
+		} else if file.Test {
+			fmt.Printf("  🧪 %s\n", filepath.ToSlash(file.Path))
+		} else {
+			fmt.Printf("  📄 %s\n", filepath.ToSlash(file.Path))
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/burak/flutter_assist/internal/bundle"
+)
+
+// runKeysCommand, bundle imzalama anahtarları için alt komutları çalıştırır
+func runKeysCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("keys alt komutu belirtilmedi (generate, trust, list, remove)")
+	}
+
+	switch args[0] {
+	case "generate":
+		return runKeysGenerate(args[1:])
+	case "trust":
+		if err := requireArgs(args[1:], 2, "keys trust <isim> <açık_anahtar|dosya.pub>"); err != nil {
+			return err
+		}
+		publicKey, err := bundle.ParsePublicKey(args[2])
+		if err != nil {
+			return err
+		}
+		if err := bundle.TrustKey(args[1], publicKey); err != nil {
+			return err
+		}
+		fmt.Printf("✅ %s anahtarı güvenilenlere eklendi (%s)\n", args[1], bundle.KeyID(publicKey))
+		return nil
+	case "list":
+		return runKeysList()
+	case "remove":
+		if err := requireArgs(args[1:], 1, "keys remove <isim>"); err != nil {
+			return err
+		}
+		if err := bundle.UntrustKey(args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("✅ %s anahtarı güvenilenlerden silindi\n", args[1])
+		return nil
+	default:
+		return fmt.Errorf("bilinmeyen keys komutu: %s", args[0])
+	}
+}
+
+// runKeysGenerate, yeni bir imzalama anahtarı oluşturur ve açık anahtarı güvenilenlere ekler
+func runKeysGenerate(args []string) error {
+	fs := flag.NewFlagSet("keys generate", flag.ExitOnError)
+	dir := fs.String("dir", ".", "Anahtar dosyalarının yazılacağı klasör")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "keys generate <isim> [-dir klasör]"); err != nil {
+		return err
+	}
+	name := positional[0]
+
+	privatePath, encoded, err := bundle.GenerateKey(name, *dir)
+	if err != nil {
+		return err
+	}
+	publicKey, err := bundle.ParsePublicKey(encoded)
+	if err != nil {
+		return err
+	}
+	if err := bundle.TrustKey(name, publicKey); err != nil {
+		return err
+	}
+
+	fmt.Printf("🔑 Özel anahtar: %s (kimseyle paylaşmayın)\n", privatePath)
+	fmt.Printf("🔓 Açık anahtar: %s\n", encoded)
+	fmt.Printf("ℹ️ Diğer makinelerde güvenmek için: flutter_assist keys trust %s %s\n", name, encoded)
+	return nil
+}
+
+// runKeysList, güvenilen anahtarları listeler
+func runKeysList() error {
+	keys, err := bundle.LoadTrustedKeys()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		fmt.Println("ℹ️ Güvenilen anahtar bulunamadı")
+		return nil
+	}
+
+	for _, key := range keys {
+		publicKey, err := bundle.ParsePublicKey(key.PublicKey)
+		if err != nil {
+			return fmt.Errorf("%s: %v", key.Name, err)
+		}
+		fmt.Printf("  🔑 %s  %s\n", key.Name, bundle.KeyID(publicKey))
+	}
+	return nil
+}
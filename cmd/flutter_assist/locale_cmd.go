@@ -0,0 +1,184 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/generator"
+	"github.com/burak/flutter_assist/internal/locale"
+)
+
+// runLocaleCommand, proje içindeki easy_localization çeviri dosyaları için alt komutları çalıştırır
+func runLocaleCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("locale alt komutu belirtilmedi (init, add, key, check)")
+	}
+
+	switch args[0] {
+	case "init":
+		return runLocaleInit(args[1:])
+	case "add":
+		return runLocaleAdd(args[1:])
+	case "key":
+		if len(args) < 2 || args[1] != "add" {
+			return fmt.Errorf("kullanım: flutter_assist locale key add <anahtar> [-value kod=metin]")
+		}
+		return runLocaleKeyAdd(args[2:])
+	case "check":
+		return runLocaleCheck(args[1:])
+	default:
+		return fmt.Errorf("bilinmeyen locale komutu: %s", args[0])
+	}
+}
+
+// runLocaleInit, verilen diller (varsayılan: projenin DEFAULT_LOCALE değeri) için çeviri altyapısını oluşturur
+func runLocaleInit(args []string) error {
+	fs := flag.NewFlagSet("locale init", flag.ExitOnError)
+	force := fs.Bool("force", false, "Elle yazılmış AppLocalizationEnum dosyasının üzerine yaz")
+	codes, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	p, err := generator.OpenProject(nil)
+	if err != nil {
+		return err
+	}
+	if len(codes) == 0 {
+		codes = []string{"tr"}
+		if code := p.Values[locale.DefaultLocaleKey]; code != "" {
+			codes = []string{code}
+		}
+	}
+
+	changed, err := locale.Init(p, codes, generator.Options{Force: *force})
+	if err != nil {
+		return err
+	}
+	printChanged(changed)
+	fmt.Printf("✅ Çeviri altyapısı hazır: %s\n", strings.Join(codes, ", "))
+	return nil
+}
+
+// runLocaleAdd, yeni bir dil ekler
+func runLocaleAdd(args []string) error {
+	fs := flag.NewFlagSet("locale add", flag.ExitOnError)
+	force := fs.Bool("force", false, "Elle yazılmış AppLocalizationEnum dosyasının üzerine yaz")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "locale add <kod> [-force]"); err != nil {
+		return err
+	}
+
+	p, err := generator.OpenProject(nil)
+	if err != nil {
+		return err
+	}
+	changed, err := locale.AddLanguage(p, positional[0], generator.Options{Force: *force})
+	if err != nil {
+		return err
+	}
+	printChanged(changed)
+	fmt.Printf("✅ %s dili eklendi\n", positional[0])
+	return printLocaleReport(p.Root)
+}
+
+// runLocaleKeyAdd, çeviri anahtarını tüm dillere ekler
+func runLocaleKeyAdd(args []string) error {
+	fs := flag.NewFlagSet("locale key add", flag.ExitOnError)
+	values := varFlags{}
+	fs.Var(values, "value", "Bir dildeki çeviri (kod=metin), birden fazla kez verilebilir")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "locale key add <anahtar> [-value kod=metin]"); err != nil {
+		return err
+	}
+
+	p, err := generator.OpenProject(nil)
+	if err != nil {
+		return err
+	}
+	updated, err := locale.AddKey(p.Root, positional[0], values)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ %s anahtarı eklendi: %s\n", positional[0], strings.Join(updated, ", "))
+	return printLocaleReport(p.Root)
+}
+
+// runLocaleCheck, eksik çevirileri raporlar. Eksik çeviri varsa hata döner
+func runLocaleCheck(args []string) error {
+	fs := flag.NewFlagSet("locale check", flag.ExitOnError)
+	fix := fs.Bool("fix", false, "Eksik anahtarları boş değerle dillere ekle")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 0, "locale check [-fix]"); err != nil {
+		return err
+	}
+
+	p, err := generator.OpenProject(nil)
+	if err != nil {
+		return err
+	}
+	if *fix {
+		updated, err := locale.Fix(p.Root)
+		if err != nil {
+			return err
+		}
+		if len(updated) > 0 {
+			fmt.Printf("✅ Eksik anahtarlar eklendi: %s\n", strings.Join(updated, ", "))
+		}
+	}
+	report, err := locale.Check(p.Root)
+	if err != nil {
+		return err
+	}
+	if len(report.Languages) == 0 {
+		return fmt.Errorf("çeviri dosyası yok, önce 'locale init' çalıştırın")
+	}
+
+	fmt.Printf("ℹ️ %d dil, %d anahtar: %s\n", len(report.Languages), len(report.Keys), strings.Join(report.Languages, ", "))
+	if err := printLocaleReport(p.Root); err != nil {
+		return err
+	}
+	if count := report.Count(); count > 0 {
+		return fmt.Errorf("%d eksik çeviri var", count)
+	}
+	return nil
+}
+
+// printLocaleReport, dillerdeki eksik ve boş anahtarları yazdırır
+func printLocaleReport(root string) error {
+	report, err := locale.Check(root)
+	if err != nil {
+		return err
+	}
+	if report.Count() == 0 {
+		fmt.Println("✅ Tüm diller senkron, eksik çeviri yok")
+		return nil
+	}
+
+	for _, lang := range report.Languages {
+		for _, key := range report.Missing[lang] {
+			fmt.Printf("  ⚠️ %s: %s eksik\n", lang, key)
+		}
+		for _, key := range report.Empty[lang] {
+			fmt.Printf("  ⚠️ %s: %s çevrilmemiş\n", lang, key)
+		}
+	}
+	return nil
+}
+
+// printChanged, oluşturulan veya güncellenen dosyaları listeler
+func printChanged(files []string) {
+	for _, file := range files {
+		fmt.Printf("  📄 %s\n", file)
+	}
+}
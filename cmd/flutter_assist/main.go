@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bufio"
 	"flag"
 	"fmt"
 	"os"
@@ -9,7 +8,12 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/burak/flutter_assist/internal/firebase"
+	"github.com/burak/flutter_assist/internal/generator"
+	"github.com/burak/flutter_assist/internal/locale"
 	"github.com/burak/flutter_assist/internal/project"
+	"github.com/burak/flutter_assist/internal/prompt"
+	"github.com/burak/flutter_assist/internal/pubspec"
 	"github.com/burak/flutter_assist/internal/template"
 )
 
@@ -22,6 +26,42 @@ func getExecutableDir() (string, error) {
 	return filepath.Dir(execPath), nil
 }
 
+// listFlags, birden fazla kez veya virgülle ayrılarak verilebilen argümanları tutar
+type listFlags []string
+
+func (l *listFlags) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlags) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// varFlags, birden fazla kez verilebilen --var key=value argümanlarını tutar
+type varFlags map[string]string
+
+func (v varFlags) String() string {
+	var pairs []string
+	for key, value := range v {
+		pairs = append(pairs, key+"="+value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (v varFlags) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return fmt.Errorf("değişken key=value formatında olmalı: %s", value)
+	}
+	v[strings.TrimSpace(key)] = val
+	return nil
+}
+
 func main() {
 	// Komut satırı argümanlarını tanımla
 	templateFlag := flag.String("t", "", "Template oluşturmak için dosya veya klasör yolu")
@@ -30,6 +70,16 @@ func main() {
 	templateDeleteFlag := flag.Bool("tdelete", false, "Template silme işlemi için")
 	templateForDeleteFlag := flag.Bool("tfdelete", false, "Template for silme işlemi için")
 	packageDeleteFlag := flag.Bool("pdelete", false, "Paket silme işlemi için")
+	vars := varFlags{}
+	flag.Var(vars, "var", "Template değişkeni (key=value), birden fazla kez verilebilir")
+	valuesFlag := flag.String("values", "", "Template değişken değerlerini içeren JSON dosyası")
+	flavorsFlag := flag.String("flavors", "", "Proje oluşturulurken flavor'ları üretmek için flavors.json dosyası")
+	var includeFlags, excludeFlags listFlags
+	flag.Var(&includeFlags, "include", "Template yakalarken sadece bu glob'lara uyan dosyaları al")
+	flag.Var(&excludeFlags, "exclude", "Template yakalarken bu glob'lara uyan dosyaları atla")
+	yesFlag := flag.Bool("y", false, "Template yakalarken proje ismi değişikliklerini sormadan kabul et")
+	depsFlag := flag.Bool("deps", false, "Template yakalarken projenin bağımlılıklarını paket olarak kaydet")
+	formatFlag := flag.String("format", template.FormatJSON, "Yakalanan template'lerin formatı (json veya tmpl)")
 	flag.Parse()
 
 	// Emoji tanımlamaları
@@ -39,6 +89,17 @@ func main() {
 		errorEmoji   = "❌"
 	)
 
+	// Alt komutlar
+	if len(flag.Args()) > 0 {
+		if run, ok := commands[flag.Args()[0]]; ok {
+			if err := run(flag.Args()[1:]); err != nil {
+				fmt.Printf("%s Hata: %v\n", errorEmoji, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	// Template silme işlemi
 	if *templateDeleteFlag {
 		execDir, err := getExecutableDir()
@@ -72,9 +133,7 @@ func main() {
 
 		// Kullanıcıdan seçim iste
 		fmt.Printf("\nℹ️ Silinecek template'lerin numaralarını boşlukla ayırarak girin (örn: 1 3): ")
-		reader := bufio.NewReader(os.Stdin)
-		input, _ := reader.ReadString('\n')
-		input = strings.TrimSpace(input)
+		input, _ := prompt.ReadLine()
 
 		// Seçilen numaraları parse et
 		selected := make(map[string]bool)
@@ -212,12 +271,30 @@ func main() {
 		fmt.Printf("%s Template oluşturma modu başlatılıyor...\n", infoEmoji)
 
 		// Template oluştur
-		err := template.CreateTemplate(*templateFlag, selectedTypes)
+		opts := template.CaptureOptions{Include: includeFlags, Exclude: excludeFlags, AcceptAll: *yesFlag, Format: *formatFlag}
+		err := template.CreateTemplate(*templateFlag, selectedTypes, opts)
 		if err != nil {
 			fmt.Printf("%s Hata: %v\n", errorEmoji, err)
 			os.Exit(1)
 		}
 
+		// Projenin bağımlılıklarını seçilen type'lar için paket olarak kaydet
+		if *depsFlag {
+			root, err := pubspec.FindRoot(*templateFlag)
+			if err != nil {
+				fmt.Printf("%s Hata: %v\n", errorEmoji, err)
+				os.Exit(1)
+			}
+			added, err := project.AddPackagesFromPubspec(filepath.Join(root, pubspec.FileName), selectedTypes)
+			if err != nil {
+				fmt.Printf("%s Hata: %v\n", errorEmoji, err)
+				os.Exit(1)
+			}
+			for _, name := range added {
+				fmt.Printf("  📦 %s paketi kaydedildi\n", name)
+			}
+		}
+
 		fmt.Printf("%s Template başarıyla oluşturuldu!\n", successEmoji)
 		return
 	}
@@ -295,12 +372,49 @@ func main() {
 			os.Exit(1)
 		}
 
+		// Değerler ve flavors dosyaları göreli verildiyse çalışma dizinine göre çöz
+		valuesFile := *valuesFlag
+		if valuesFile != "" && !filepath.IsAbs(valuesFile) {
+			if wd := os.Getenv("PWD"); wd != "" {
+				valuesFile = filepath.Join(wd, valuesFile)
+			}
+		}
+		flavorsFile := *flavorsFlag
+		if flavorsFile != "" && !filepath.IsAbs(flavorsFile) {
+			if wd := os.Getenv("PWD"); wd != "" {
+				flavorsFile = filepath.Join(wd, flavorsFile)
+			}
+		}
+
 		// Projeyi oluştur
-		if err := project.CreateProject(projectName, selectedTypes); err != nil {
+		opts := project.CreateOptions{Vars: vars, ValuesFile: valuesFile}
+		if err := project.CreateProject(projectName, selectedTypes, opts); err != nil {
 			fmt.Printf("❌ Proje oluşturulamadı: %v\n", err)
 			return
 		}
 
+		// Localization template'leri kullanıldıysa çeviri dosyalarını oluştur
+		changed, err := locale.InitProject(filepath.Join(os.Getenv("PWD"), projectName))
+		if err != nil {
+			fmt.Printf("⚠️ Çeviri dosyaları oluşturulamadı: %v\n", err)
+		}
+		printChanged(changed)
+
+		// FIREBASE type'ı flutterfire configure yerine taslak yapılandırma ile oluşturulduysa uyar
+		if status, err := firebase.Check(filepath.Join(os.Getenv("PWD"), projectName)); err == nil && status.Stub {
+			printFirebaseStub(status)
+		}
+
+		// Flavors dosyası verildiyse flavor giriş noktalarını ve .env dosyalarını oluştur
+		if flavorsFile != "" {
+			result, err := generator.InitFlavors(filepath.Join(os.Getenv("PWD"), projectName), flavorsFile)
+			if err != nil {
+				fmt.Printf("⚠️ Flavor dosyaları oluşturulamadı: %v\n", err)
+			} else {
+				printFlavors(result, false)
+			}
+		}
+
 		fmt.Printf("%s Proje başarıyla oluşturuldu!\n", successEmoji)
 		return
 	}
@@ -308,7 +422,45 @@ func main() {
 	// Yardım mesajı
 	fmt.Printf("%s Kullanım:\n", infoEmoji)
 	fmt.Println("  flutter_assist <proje_ismi>          - Proje oluştur")
+	fmt.Println("    -var KEY=value                     - Template değişkeni ver (tekrarlanabilir)")
+	fmt.Println("    -values <dosya.json>               - Template değişkenlerini dosyadan oku")
+	fmt.Println("    -flavors <flavors.json>            - dev/staging/prod flavor'larını oluştur")
 	fmt.Println("  flutter_assist -t <template_ismi>    - Template oluştur")
+	fmt.Println("    -include <glob>                    - Sadece uyan dosyaları yakala (tekrarlanabilir)")
+	fmt.Println("    -exclude <glob>                    - Uyan dosyaları atla (tekrarlanabilir)")
+	fmt.Println("    -y                                 - Proje ismi değişikliklerini sormadan kabul et")
+	fmt.Println("    -deps                              - Projenin bağımlılıklarını paket olarak kaydet")
+	fmt.Println("    -format json|tmpl                  - Template'leri JSON veya ham kaynak olarak kaydet")
+	fmt.Println("  flutter_assist template show <isim>  - Template'i render edip göster")
+	fmt.Println("  flutter_assist template diff <isim> <dosya> - Template'i projedeki dosya ile karşılaştır")
+	fmt.Println("  flutter_assist template edit <isim>  - Template'i $EDITOR ile düzenle")
+	fmt.Println("  flutter_assist template convert <isim>|-all -to tmpl|json - Template formatını değiştir")
+	fmt.Println("  flutter_assist export <arsiv.tar.gz> - Type, paket ve template'leri arşive aktar")
+	fmt.Println("  flutter_assist import <arsiv>        - Arşivi mevcut yapılandırmaya birleştir")
+	fmt.Println("    -on-conflict skip|overwrite|rename - Çakışmalarda yapılacak işlem")
+	fmt.Println("    -dry-run                           - Sadece önizleme göster")
+	fmt.Println("    -allow-unsigned                    - İmzasız arşivleri kabul et")
+	fmt.Println("  flutter_assist source add <isim> <git-url>@<ref> - Git deposunu template kaynağı olarak ekle")
+	fmt.Println("  flutter_assist source update [isim]  - Kaynakları güncelle")
+	fmt.Println("  flutter_assist source list|remove    - Kaynakları listele veya sil")
+	fmt.Println("  flutter_assist search [sorgu]        - Registry'deki bundle'ları ara")
+	fmt.Println("  flutter_assist install <isim>[@sürüm] - Registry'den bundle kur")
+	fmt.Println("  flutter_assist publish <arsiv>       - Arşivi registry'de yayınla")
+	fmt.Println("  flutter_assist registry serve <klasör> - Klasördeki bundle'ları yerel registry olarak sun")
+	fmt.Println("  flutter_assist registry set <url>    - Varsayılan registry adresini kaydet")
+	fmt.Println("  flutter_assist keys generate <isim>  - Bundle imzalama anahtarı oluştur")
+	fmt.Println("  flutter_assist keys trust|list|remove - Güvenilen anahtarları yönet")
+	fmt.Println("  flutter_assist gen feature <isim>    - Proje içinde lib/feature/<isim>/ modülünü ve testlerini üret")
+	fmt.Println("  flutter_assist gen bloc|cubit <isim> - Bloc veya cubit dosyalarını ve testlerini üret (flutter_bloc gerekir)")
+	fmt.Println("  flutter_assist gen model <İsim> -from ornek.json - Örnek JSON'dan model sınıfları üret")
+	fmt.Println("  flutter_assist gen routes            - Sayfaları tarayıp route tablosunu üret")
+	fmt.Println("  flutter_assist gen assets            - assets/ klasöründen asset sabitlerini üret")
+	fmt.Println("  flutter_assist gen flavors           - flavors.json dosyasından flavor dosyalarını üret")
+	fmt.Println("  flutter_assist gen di                - Servis ve repository sınıflarını get_it'e kaydet")
+	fmt.Println("  flutter_assist locale init|add <kod> - Çeviri dosyalarını ve AppLocalizationEnum'u oluştur")
+	fmt.Println("  flutter_assist locale key add <anahtar> - Çeviri anahtarını tüm dillere ekle")
+	fmt.Println("  flutter_assist locale check          - Eksik çevirileri raporla")
+	fmt.Println("  flutter_assist firebase check [-strict] - firebase_options.dart taslağı kullanılıyor mu kontrol et")
 	fmt.Println("  flutter_assist -p                    - Paket ekle")
 	fmt.Println("  flutter_assist -tf                   - Template for ekle")
 	fmt.Println("  flutter_assist -tdelete              - Template'leri sil")
@@ -317,7 +469,7 @@ func main() {
 }
 
 func selectTypes() []string {
-	types, err := project.GetTemplateTypes()
+	types, err := project.GetAllTypes()
 	if err != nil {
 		fmt.Printf("❌ Type'lar alınamadı: %v\n", err)
 		return nil
@@ -331,9 +483,7 @@ func selectTypes() []string {
 
 	// Kullanıcıdan seçim iste
 	fmt.Printf("\nℹ️ Seçilecek type'ların numaralarını boşlukla ayırarak girin (örn: 1 3): ")
-	reader := bufio.NewReader(os.Stdin)
-	input, _ := reader.ReadString('\n')
-	input = strings.TrimSpace(input)
+	input, _ := prompt.ReadLine()
 
 	// Seçilen numaraları parse et
 	selected := make(map[string]bool)
@@ -356,18 +506,16 @@ func selectTypes() []string {
 	return result
 }
 
-func selectFromList(items []string, prompt string) (string, error) {
+func selectFromList(items []string, title string) (string, error) {
 	// Öğeleri listele
-	fmt.Println(prompt)
+	fmt.Println(title)
 	for i, item := range items {
 		fmt.Printf("%d. %s\n", i+1, item)
 	}
 
 	// Kullanıcıdan seçim iste
 	fmt.Printf("\nℹ️ Seçilen öğelerin numaralarını boşlukla ayırarak girin (örn: 1 3): ")
-	reader := bufio.NewReader(os.Stdin)
-	input, _ := reader.ReadString('\n')
-	input = strings.TrimSpace(input)
+	input, _ := prompt.ReadLine()
 
 	// Seçilen numaraları parse et
 	selected := make(map[string]bool)
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/bundle"
+	"github.com/burak/flutter_assist/internal/registry"
+)
+
+// registryFlag, registry adresini tanımlayan ortak flag'i ekler
+func registryFlag(fs *flag.FlagSet) *string {
+	return fs.String("registry", "", "Registry adresi (verilmezse template_util/registry.json kullanılır)")
+}
+
+// registryClient, flag ile verilen veya kayıtlı registry adresi için client döndürür
+func registryClient(url string) (*registry.Client, error) {
+	if url == "" {
+		var err error
+		if url, err = registry.DefaultURL(); err != nil {
+			return nil, err
+		}
+	}
+	return registry.NewClient(url), nil
+}
+
+// runSearch, registry'deki bundle'ları isim veya açıklamaya göre arar
+func runSearch(args []string) error {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	url := registryFlag(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	client, err := registryClient(*url)
+	if err != nil {
+		return err
+	}
+	idx, err := client.Index()
+	if err != nil {
+		return err
+	}
+
+	results := idx.Search(strings.Join(positional, " "))
+	if len(results) == 0 {
+		fmt.Println("ℹ️ Eşleşen bundle bulunamadı")
+		return nil
+	}
+
+	for _, entry := range results {
+		fmt.Printf("  📦 %s@%s", entry.Name, entry.Version)
+		if entry.Description != "" {
+			fmt.Printf("  - %s", entry.Description)
+		}
+		fmt.Println()
+		if versions := idx.Versions(entry.Name); len(versions) > 1 {
+			fmt.Printf("     sürümler: %s\n", strings.Join(versions, ", "))
+		}
+	}
+	return nil
+}
+
+// runInstall, registry'deki bir bundle'ı indirip mevcut yapılandırmaya birleştirir
+func runInstall(args []string) error {
+	fs := flag.NewFlagSet("install", flag.ExitOnError)
+	url := registryFlag(fs)
+	strategy := fs.String("on-conflict", bundle.ConflictSkip, "Çakışmalarda yapılacak işlem (skip, overwrite, rename)")
+	dryRun := fs.Bool("dry-run", false, "Sadece önizleme göster, değişiklik yapma")
+	yes := fs.Bool("y", false, "Onay sormadan uygula")
+	allowUnsigned := fs.Bool("allow-unsigned", false, "İmzasız veya güvenilmeyen anahtarla imzalanmış bundle'ları kabul et")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "install <isim>[@sürüm] [-registry url] [-on-conflict skip|overwrite|rename] [-dry-run] [-y] [-allow-unsigned]"); err != nil {
+		return err
+	}
+
+	name, version := positional[0], ""
+	if i := strings.LastIndex(name, "@"); i > 0 {
+		name, version = name[:i], name[i+1:]
+	}
+
+	client, err := registryClient(*url)
+	if err != nil {
+		return err
+	}
+	idx, err := client.Index()
+	if err != nil {
+		return err
+	}
+	entry, err := idx.Find(name, version)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("ℹ️ %s@%s indiriliyor...\n", entry.Name, entry.Version)
+	b, err := client.Download(entry)
+	if err != nil {
+		return err
+	}
+
+	return applyBundle(b, true, *strategy, *dryRun, *yes, *allowUnsigned)
+}
+
+// runPublish, export ile oluşturulan arşivi registry'de yayınlar
+func runPublish(args []string) error {
+	fs := flag.NewFlagSet("publish", flag.ExitOnError)
+	url := registryFlag(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "publish <arsiv> [-registry url]"); err != nil {
+		return err
+	}
+
+	client, err := registryClient(*url)
+	if err != nil {
+		return err
+	}
+	entry, err := client.Publish(positional[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ %s@%s yayınlandı (%s)\n", entry.Name, entry.Version, client.URL)
+	fmt.Printf("🔒 sha256: %s\n", entry.SHA256)
+	return nil
+}
+
+// runRegistryCommand, "registry" alt komutlarını çalıştırır
+func runRegistryCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("registry alt komutu belirtilmedi (serve, set)")
+	}
+
+	switch args[0] {
+	case "serve":
+		return runRegistryServe(args[1:])
+	case "set":
+		if err := requireArgs(args[1:], 1, "registry set <url>"); err != nil {
+			return err
+		}
+		if err := registry.SetDefaultURL(args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Varsayılan registry: %s\n", args[1])
+		return nil
+	default:
+		return fmt.Errorf("bilinmeyen registry komutu: %s", args[0])
+	}
+}
+
+// runRegistryServe, bir klasördeki bundle'ları yerel bir registry olarak sunar
+func runRegistryServe(args []string) error {
+	fs := flag.NewFlagSet("registry serve", flag.ExitOnError)
+	addr := fs.String("addr", "127.0.0.1:8420", "Dinlenecek adres")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "registry serve <klasör> [-addr host:port]"); err != nil {
+		return err
+	}
+
+	server, err := registry.NewServer(positional[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🚀 Registry http://%s adresinde %d bundle ile sunuluyor (%s)\n", *addr, server.Entries(), positional[0])
+	return http.ListenAndServe(*addr, server)
+}
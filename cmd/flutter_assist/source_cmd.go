@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/burak/flutter_assist/internal/source"
+)
+
+// runSourceCommand, "source" alt komutlarını çalıştırır
+func runSourceCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("source alt komutu belirtilmedi (add, update, list, remove)")
+	}
+
+	switch args[0] {
+	case "add":
+		return runSourceAdd(args[1:])
+	case "update":
+		return runSourceUpdate(args[1:])
+	case "list":
+		return runSourceList()
+	case "remove":
+		return runSourceRemove(args[1:])
+	default:
+		return fmt.Errorf("bilinmeyen source komutu: %s", args[0])
+	}
+}
+
+// runSourceAdd, git deposunu template kaynağı olarak ekler
+func runSourceAdd(args []string) error {
+	if err := requireArgs(args, 2, "source add <isim> <git-url>[@ref]"); err != nil {
+		return err
+	}
+
+	fmt.Printf("ℹ️ %s klonlanıyor...\n", args[1])
+	src, err := source.Add(args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ %s kaynağı eklendi (%s)\n", src.Name, shortCommit(src.Commit))
+	fmt.Printf("ℹ️ Template ve type'lar %s%s önekiyle kullanılabilir\n", src.Name, source.Separator)
+	return nil
+}
+
+// runSourceUpdate, kaynakları fetch edip kayıtlı ref'lerine günceller
+func runSourceUpdate(args []string) error {
+	before := make(map[string]string)
+	sources, err := source.Load()
+	if err != nil {
+		return err
+	}
+	for _, s := range sources {
+		before[s.Name] = s.Commit
+	}
+
+	updated, err := source.Update(args)
+	if err != nil {
+		return err
+	}
+	if len(updated) == 0 {
+		fmt.Println("ℹ️ Güncellenecek kaynak bulunamadı")
+		return nil
+	}
+
+	for _, s := range updated {
+		if before[s.Name] == s.Commit {
+			fmt.Printf("  = %s güncel (%s)\n", s.Name, shortCommit(s.Commit))
+		} else {
+			fmt.Printf("  🔄 %s: %s -> %s\n", s.Name, shortCommit(before[s.Name]), shortCommit(s.Commit))
+		}
+	}
+	return nil
+}
+
+// runSourceList, kayıtlı kaynakları listeler
+func runSourceList() error {
+	sources, err := source.Load()
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		fmt.Println("ℹ️ Kayıtlı kaynak bulunamadı")
+		return nil
+	}
+
+	for _, s := range sources {
+		ref := s.Ref
+		if ref == "" {
+			ref = "varsayılan branch"
+		}
+		fmt.Printf("  📚 %s  %s@%s (%s)\n", s.Name, s.URL, ref, shortCommit(s.Commit))
+	}
+	return nil
+}
+
+// runSourceRemove, kaynağı ve önbelleğini siler
+func runSourceRemove(args []string) error {
+	if err := requireArgs(args, 1, "source remove <isim>"); err != nil {
+		return err
+	}
+	if err := source.Remove(args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("✅ %s kaynağı silindi\n", args[0])
+	return nil
+}
+
+// shortCommit, commit hash'inin kısa halini döndürür
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	if commit == "" {
+		return "-"
+	}
+	return commit
+}
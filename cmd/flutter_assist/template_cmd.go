@@ -0,0 +1,225 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/burak/flutter_assist/internal/project"
+	"github.com/burak/flutter_assist/internal/pubspec"
+	"github.com/burak/flutter_assist/internal/source"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// runTemplateCommand, "template" alt komutlarını çalıştırır
+func runTemplateCommand(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("template alt komutu belirtilmedi (show, diff, edit, convert)")
+	}
+
+	switch args[0] {
+	case "show":
+		return runTemplateShow(args[1:])
+	case "diff":
+		return runTemplateDiff(args[1:])
+	case "edit":
+		return runTemplateEdit(args[1:])
+	case "convert":
+		return runTemplateConvert(args[1:])
+	default:
+		return fmt.Errorf("bilinmeyen template komutu: %s", args[0])
+	}
+}
+
+// previewFlags, template'i proje dışında render etmek için ortak flag'leri tanımlar
+func previewFlags(fs *flag.FlagSet) (*string, *string, varFlags) {
+	projectName := fs.String("project", "", "Render için kullanılacak proje ismi")
+	types := fs.String("types", "", "Render için seçili type'lar (virgülle ayrılmış)")
+	vars := varFlags{}
+	fs.Var(vars, "var", "Template değişkeni (key=value), birden fazla kez verilebilir")
+	return projectName, types, vars
+}
+
+// runTemplateShow, kayıtlı bir template'i verilen proje ismi ve type'lar ile render edip gösterir
+func runTemplateShow(args []string) error {
+	fs := flag.NewFlagSet("template show", flag.ExitOnError)
+	projectName, types, vars := previewFlags(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 1, "template show <template_ismi> [-project isim] [-types A,B] [-var KEY=value]"); err != nil {
+		return err
+	}
+
+	name := *projectName
+	if name == "" {
+		name = "my_app"
+	}
+
+	files, err := template.RenderTemplate(positional[0], template.PreviewOptions{
+		ProjectName: name,
+		Types:       splitList(*types),
+		Vars:        vars,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.Test {
+			fmt.Printf("🧪 %s\n", file.Path)
+		} else {
+			fmt.Printf("📄 %s\n", file.Path)
+		}
+		if file.Binary {
+			fmt.Printf("(binary dosya, %d byte)\n\n", len(file.Content))
+			continue
+		}
+		fmt.Println(file.Content)
+	}
+	return nil
+}
+
+// runTemplateDiff, kayıtlı bir template'i gerçek projedeki bir dosya ile karşılaştırır
+func runTemplateDiff(args []string) error {
+	fs := flag.NewFlagSet("template diff", flag.ExitOnError)
+	projectName, types, vars := previewFlags(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(positional, 2, "template diff <template_ismi> <dosya> [-project isim] [-types A,B] [-var KEY=value]"); err != nil {
+		return err
+	}
+	templateName, filePath := positional[0], positional[1]
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("dosya okunamadı: %v", err)
+	}
+
+	// Proje ismi verilmediyse dosyanın bulunduğu Flutter projesinden oku
+	root, _ := pubspec.FindRoot(filePath)
+	name := *projectName
+	if name == "" && root != "" {
+		name, _ = pubspec.Name(filepath.Join(root, pubspec.FileName))
+	}
+	if name == "" {
+		return fmt.Errorf("proje ismi bulunamadı, -project ile belirtin")
+	}
+
+	files, err := template.RenderTemplate(templateName, template.PreviewOptions{
+		ProjectName: name,
+		Types:       splitList(*types),
+		Vars:        vars,
+	})
+	if err != nil {
+		return err
+	}
+
+	rendered, err := pickRenderedFile(files, filePath, root)
+	if err != nil {
+		return err
+	}
+
+	if rendered.Binary {
+		if rendered.Content == string(data) {
+			fmt.Println("✅ Template ile dosya aynı")
+		} else {
+			fmt.Printf("ℹ️ Binary içerik farklı (template: %d byte, dosya: %d byte)\n", len(rendered.Content), len(data))
+		}
+		return nil
+	}
+
+	diff := template.UnifiedDiff("template/"+rendered.Path, filePath, rendered.Content, string(data))
+	if diff == "" {
+		fmt.Println("✅ Template ile dosya aynı")
+		return nil
+	}
+
+	fmt.Print(diff)
+	fmt.Printf("\nℹ️ Dosya template'ten farklı. Yeniden yakalamak için: flutter_assist -t %s\n", filePath)
+	return nil
+}
+
+// pickRenderedFile, birden fazla dosya üreten template'lerde karşılaştırılacak dosyayı seçer
+func pickRenderedFile(files []template.RenderedFile, filePath string, root string) (template.RenderedFile, error) {
+	if len(files) == 1 {
+		return files[0], nil
+	}
+
+	if root != "" {
+		if absPath, err := filepath.Abs(filePath); err == nil {
+			if relPath, err := filepath.Rel(root, absPath); err == nil {
+				for _, file := range files {
+					if file.Path == relPath {
+						return file, nil
+					}
+				}
+			}
+		}
+	}
+
+	return template.RenderedFile{}, fmt.Errorf("template %d dosya üretiyor ve hiçbiri %s ile eşleşmiyor", len(files), filePath)
+}
+
+// runTemplateEdit, kayıtlı bir template'i $EDITOR ile düzenler
+func runTemplateEdit(args []string) error {
+	if err := requireArgs(args, 1, "template edit <template_ismi>"); err != nil {
+		return err
+	}
+
+	if err := template.EditTemplate(args[0]); err != nil {
+		return err
+	}
+
+	fmt.Println("✅ Template başarıyla güncellendi!")
+	return nil
+}
+
+// runTemplateConvert, template'leri JSON ve ham kaynak formatları arasında dönüştürür
+func runTemplateConvert(args []string) error {
+	fs := flag.NewFlagSet("template convert", flag.ExitOnError)
+	format := fs.String("to", template.FormatSource, "Hedef format (json veya tmpl)")
+	all := fs.Bool("all", false, "Tüm template'leri dönüştür")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	if *all {
+		if names, err = project.GetTemplates(); err != nil {
+			return err
+		}
+	} else {
+		if err := requireArgs(positional, 1, "template convert <template_ismi>|-all [-to tmpl|json]"); err != nil {
+			return err
+		}
+		names = positional
+	}
+
+	converted := 0
+	for _, name := range names {
+		if sourceName, _ := source.Split(name); sourceName != "" {
+			return fmt.Errorf("%s kaynağının template'leri dönüştürülemez", sourceName)
+		}
+		templateFile, err := template.FindTemplate(name)
+		if err != nil {
+			return err
+		}
+		newFile, err := template.ConvertTemplate(templateFile, *format)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		if newFile != templateFile {
+			fmt.Printf("🔄 %s -> %s\n", filepath.Base(templateFile), filepath.Base(newFile))
+			converted++
+		}
+	}
+
+	fmt.Printf("✅ %d template dönüştürüldü\n", converted)
+	return nil
+}
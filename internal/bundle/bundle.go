@@ -0,0 +1,383 @@
+package bundle
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FormatVersion, arşiv formatının sürümüdür. Format değişirse artırılır
+const FormatVersion = 1
+
+// ManifestName, arşivin kökündeki manifest dosyasının ismidir
+const ManifestName = "manifest.json"
+
+// ChecksumExt, arşivin yanına yazılan checksum dosyasının uzantısıdır
+const ChecksumExt = ".sha256"
+
+// Manifest, arşivin sürüm bilgisini ve içindeki dosyaların checksum'larını tutar
+type Manifest struct {
+	FormatVersion int    `json:"format_version"`
+	Name          string `json:"name,omitempty"`
+	Version       string `json:"version,omitempty"`
+	Description   string `json:"description,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	Files         []File `json:"files"`
+}
+
+// File, arşivdeki tek bir dosyanın template_util'e göre yolunu ve checksum'ını tutar
+type File struct {
+	Path   string `json:"path"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Bundle, belleğe okunmuş bir arşivdir. Files anahtarları "/" ayraçlı göreli yollardır
+type Bundle struct {
+	Manifest Manifest
+	Files    map[string][]byte
+	// Signature, arşiv imzalanmışsa manifestin ed25519 imzası
+	Signature *Signature
+	// manifestData, imzanın doğrulandığı ham manifest içeriği
+	manifestData []byte
+}
+
+// UtilDir, çalıştırılabilir dosyanın yanındaki template_util klasörünün yolunu döndürür
+func UtilDir() (string, error) {
+	execPath, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
+	}
+	return filepath.Join(filepath.Dir(execPath), "template_util"), nil
+}
+
+// Checksum, verinin sha256 özetini hex olarak döndürür
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// New, verilen dosyalar için manifest oluşturur
+func New(info Manifest, files map[string][]byte) *Bundle {
+	manifest := Manifest{
+		FormatVersion: FormatVersion,
+		Name:          info.Name,
+		Version:       info.Version,
+		Description:   info.Description,
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, p := range sortedPaths(files) {
+		manifest.Files = append(manifest.Files, File{Path: p, Size: len(files[p]), SHA256: Checksum(files[p])})
+	}
+	return &Bundle{Manifest: manifest, Files: files}
+}
+
+// Write, arşivi uzantısına göre tar.gz veya zip olarak yazar ve arşivin checksum'ını döndürür
+func (b *Bundle) Write(archivePath string) (string, error) {
+	manifest, err := b.manifestBytes()
+	if err != nil {
+		return "", err
+	}
+	meta := map[string][]byte{ManifestName: manifest}
+	if b.Signature != nil {
+		signature, err := json.MarshalIndent(b.Signature, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("imza dönüştürme hatası: %v", err)
+		}
+		meta[SignatureName] = signature
+	}
+
+	var buf bytes.Buffer
+	switch {
+	case isTarGz(archivePath):
+		err = writeTarGz(&buf, meta, b.Files)
+	case strings.HasSuffix(archivePath, ".zip"):
+		err = writeZip(&buf, meta, b.Files)
+	default:
+		return "", fmt.Errorf("desteklenmeyen arşiv uzantısı: %s (.tar.gz, .tgz veya .zip olmalı)", archivePath)
+	}
+	if err != nil {
+		return "", fmt.Errorf("arşiv oluşturulamadı: %v", err)
+	}
+
+	if err := os.WriteFile(archivePath, buf.Bytes(), 0644); err != nil {
+		return "", fmt.Errorf("arşiv kaydedilemedi: %v", err)
+	}
+	return Checksum(buf.Bytes()), nil
+}
+
+// Read, arşivi okur, manifest ve dosya checksum'larını doğrular
+func Read(archivePath string) (*Bundle, error) {
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("arşiv okunamadı: %v", err)
+	}
+	return Parse(archivePath, data)
+}
+
+// Parse, bellekteki arşivi ismine göre açar ve doğrular
+func Parse(archiveName string, data []byte) (*Bundle, error) {
+	var entries map[string][]byte
+	var err error
+	switch {
+	case isTarGz(archiveName):
+		entries, err = readTarGz(data)
+	case strings.HasSuffix(archiveName, ".zip"):
+		entries, err = readZip(data)
+	default:
+		return nil, fmt.Errorf("desteklenmeyen arşiv uzantısı: %s (.tar.gz, .tgz veya .zip olmalı)", archiveName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("arşiv açılamadı: %v", err)
+	}
+
+	raw, ok := entries[ManifestName]
+	if !ok {
+		return nil, fmt.Errorf("arşivde %s bulunamadı", ManifestName)
+	}
+	delete(entries, ManifestName)
+
+	var manifest Manifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return nil, fmt.Errorf("manifest JSON parse hatası: %v", err)
+	}
+	if manifest.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("arşiv formatı desteklenmiyor: %d (en fazla %d)", manifest.FormatVersion, FormatVersion)
+	}
+
+	b := &Bundle{Manifest: manifest, Files: entries, manifestData: raw}
+	if data, ok := entries[SignatureName]; ok {
+		delete(entries, SignatureName)
+		var signature Signature
+		if err := json.Unmarshal(data, &signature); err != nil {
+			return nil, fmt.Errorf("imza JSON parse hatası: %v", err)
+		}
+		b.Signature = &signature
+	}
+
+	if err := b.Verify(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Verify, arşivdeki dosyaların manifest ile birebir eşleştiğini kontrol eder
+func (b *Bundle) Verify() error {
+	listed := make(map[string]bool)
+	for _, f := range b.Manifest.Files {
+		listed[f.Path] = true
+		data, ok := b.Files[f.Path]
+		if !ok {
+			return fmt.Errorf("manifestteki dosya arşivde yok: %s", f.Path)
+		}
+		if sum := Checksum(data); sum != f.SHA256 {
+			return fmt.Errorf("checksum uyuşmuyor: %s (beklenen %s, bulunan %s)", f.Path, f.SHA256, sum)
+		}
+	}
+	for p := range b.Files {
+		if !listed[p] {
+			return fmt.Errorf("arşivde manifestte olmayan dosya var: %s", p)
+		}
+	}
+	return nil
+}
+
+// VerifyChecksumFile, arşivin yanında .sha256 dosyası varsa arşivin checksum'ını kontrol eder.
+// Dosya yoksa false döner.
+func VerifyChecksumFile(archivePath string) (bool, error) {
+	expected, err := os.ReadFile(archivePath + ChecksumExt)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("checksum dosyası okunamadı: %v", err)
+	}
+
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return false, fmt.Errorf("arşiv okunamadı: %v", err)
+	}
+
+	fields := strings.Fields(string(expected))
+	if len(fields) == 0 || fields[0] != Checksum(data) {
+		return true, fmt.Errorf("arşiv checksum'ı uyuşmuyor: %s", filepath.Base(archivePath))
+	}
+	return true, nil
+}
+
+// WriteChecksumFile, arşivin yanına sha256sum uyumlu bir checksum dosyası yazar
+func WriteChecksumFile(archivePath string, sum string) error {
+	line := fmt.Sprintf("%s  %s\n", sum, filepath.Base(archivePath))
+	if err := os.WriteFile(archivePath+ChecksumExt, []byte(line), 0644); err != nil {
+		return fmt.Errorf("checksum dosyası kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+func isTarGz(name string) bool {
+	return strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz")
+}
+
+// manifestBytes, arşive yazılacak manifest içeriğini döndürür. İmzalanmış veya okunmuş
+// arşivlerde imzanın geçerli kalması için ham içerik olduğu gibi kullanılır.
+func (b *Bundle) manifestBytes() ([]byte, error) {
+	if b.manifestData != nil {
+		return b.manifestData, nil
+	}
+	data, err := json.MarshalIndent(b.Manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("manifest dönüştürme hatası: %v", err)
+	}
+	return data, nil
+}
+
+// writeTarGz, önce manifest ve imza gibi meta dosyalarını, sonra template_util dosyalarını yazar
+func writeTarGz(w io.Writer, meta map[string][]byte, files map[string][]byte) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	write := func(name string, data []byte) error {
+		header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: time.Now()}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	for _, p := range sortedPaths(meta) {
+		if err := write(p, meta[p]); err != nil {
+			return err
+		}
+	}
+	for _, p := range sortedPaths(files) {
+		if err := write(p, files[p]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// writeZip, önce manifest ve imza gibi meta dosyalarını, sonra template_util dosyalarını yazar
+func writeZip(w io.Writer, meta map[string][]byte, files map[string][]byte) error {
+	zw := zip.NewWriter(w)
+
+	write := func(name string, data []byte) error {
+		f, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	}
+
+	for _, p := range sortedPaths(meta) {
+		if err := write(p, meta[p]); err != nil {
+			return err
+		}
+	}
+	for _, p := range sortedPaths(files) {
+		if err := write(p, files[p]); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func readTarGz(data []byte) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	entries := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name, err := cleanEntry(header.Name)
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		entries[name] = content
+	}
+}
+
+func readZip(data []byte) (map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string][]byte)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		name, err := cleanEntry(f.Name)
+		if err != nil {
+			return nil, err
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		entries[name] = content
+	}
+	return entries, nil
+}
+
+// cleanEntry, arşivdeki dosya isminin template_util dışına çıkmadığını kontrol eder
+func cleanEntry(name string) (string, error) {
+	cleaned := path.Clean(strings.TrimPrefix(name, "./"))
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("geçersiz arşiv yolu: %s", name)
+	}
+	return cleaned, nil
+}
+
+func sortedPaths(files map[string][]byte) []string {
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,101 @@
+package bundle
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/source"
+)
+
+// localFiles, makineye özel olduğu için dışa aktarılmayan template_util dosyaları.
+// sources.json kullanıcının git adreslerini tutar, arşivden alınırsa sync başka depoları klonlar.
+var localFiles = []string{"registry.json", TrustedKeysName, source.FileName}
+
+// Collect, template_util klasöründeki type, paket, değişken, template ve partial dosyalarını okur.
+// Gizli dosyalar (.DS_Store gibi) ve makineye özel ayarlar atlanır.
+func Collect(utilDir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.WalkDir(utilDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(d.Name(), ".") && filePath != utilDir {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(utilDir, filePath)
+		if err != nil {
+			return err
+		}
+		if isLocalFile(filepath.ToSlash(relPath)) {
+			return nil
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(relPath)] = data
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("template_util okunamadı: %v", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("dışa aktarılacak dosya bulunamadı: %s", utilDir)
+	}
+	return files, nil
+}
+
+// Export, template_util klasörünü manifest ile birlikte arşive yazar ve yanına checksum dosyası oluşturur.
+// info'daki isim, sürüm ve açıklama manifeste yazılır, key verilirse manifest imzalanır.
+// Oluşturulan manifest ve arşivin checksum'ı döndürülür.
+func Export(archivePath string, info Manifest, key ed25519.PrivateKey) (*Manifest, string, error) {
+	utilDir, err := UtilDir()
+	if err != nil {
+		return nil, "", err
+	}
+
+	files, err := Collect(utilDir)
+	if err != nil {
+		return nil, "", err
+	}
+
+	b := New(info, files)
+	if key != nil {
+		if err := b.Sign(key); err != nil {
+			return nil, "", err
+		}
+	}
+	sum, err := b.Write(archivePath)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := WriteChecksumFile(archivePath, sum); err != nil {
+		return nil, "", err
+	}
+	return &b.Manifest, sum, nil
+}
+
+// isLocalFile, dosyanın makineye özel olup olmadığını döndürür. Nokta ile başlayan klasörler
+// (.sources git önbelleği gibi) da makineye özeldir, arşivden bu klasörlere dosya yazılmaz
+func isLocalFile(rel string) bool {
+	if strings.HasPrefix(rel, ".") {
+		return true
+	}
+	for _, local := range localFiles {
+		if rel == local {
+			return true
+		}
+	}
+	return false
+}
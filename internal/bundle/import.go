@@ -0,0 +1,299 @@
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/project"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// Çakışma stratejileri
+const (
+	// ConflictSkip, mevcut dosya veya kaydı korur
+	ConflictSkip = "skip"
+	// ConflictOverwrite, mevcut dosya veya kaydı arşivdeki ile değiştirir
+	ConflictOverwrite = "overwrite"
+	// ConflictRename, arşivdeki dosyayı yeni bir isimle kaydeder. Type, paket ve değişken kayıtlarında skip gibi davranır
+	ConflictRename = "rename"
+)
+
+// İçe aktarma sırasında her dosya veya kayıt için yapılacak işlemler
+const (
+	ActionAdd       = "ekle"
+	ActionSame      = "aynı"
+	ActionOverwrite = "üzerine yaz"
+	ActionSkip      = "atla"
+	ActionRename    = "yeniden adlandır"
+)
+
+// Change, içe aktarma önizlemesindeki tek bir değişikliği tanımlar
+type Change struct {
+	// Path, template_util'e göre dosya yolu
+	Path string
+	// Name, type, paket veya değişken kaydının ismi. Dosya değişikliklerinde boştur
+	Name   string
+	Action string
+	// Target, yeniden adlandırılan dosyanın yeni yolu
+	Target string
+}
+
+// Plan, bir arşivin mevcut yapılandırmaya nasıl birleştirileceğini tutar
+type Plan struct {
+	Bundle  *Bundle
+	Changes []Change
+	writes  map[string][]byte
+}
+
+// namedFiles, kayıt bazında birleştirilen yapılandırma dosyaları
+var namedFiles = []string{"template_for.json", "packages.json", "variables.json"}
+
+// NewPlan, arşivdeki dosyaları utilDir ile karşılaştırıp verilen stratejiye göre bir plan oluşturur
+func NewPlan(b *Bundle, utilDir string, strategy string) (*Plan, error) {
+	switch strategy {
+	case ConflictSkip, ConflictOverwrite, ConflictRename:
+	default:
+		return nil, fmt.Errorf("geçersiz çakışma stratejisi: %s (skip, overwrite veya rename olmalı)", strategy)
+	}
+
+	p := &Plan{Bundle: b, writes: make(map[string][]byte)}
+
+	for _, name := range namedFiles {
+		if _, ok := b.Files[name]; !ok {
+			continue
+		}
+		if err := p.mergeConfig(utilDir, name, strategy); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rel := range sortedPaths(b.Files) {
+		if isNamedFile(rel) {
+			continue
+		}
+		// Güvenilen anahtarlar gibi makineye özel dosyalar arşivden asla alınmaz
+		if isLocalFile(rel) {
+			p.Changes = append(p.Changes, Change{Path: rel, Action: ActionSkip})
+			continue
+		}
+		if err := p.mergeFile(utilDir, rel, strategy); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Count, plandaki verilen işlem sayısını döndürür
+func (p *Plan) Count(action string) int {
+	count := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// Apply, planı utilDir'e yazar
+func (p *Plan) Apply(utilDir string) error {
+	for rel, data := range p.writes {
+		target := filepath.Join(utilDir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("klasör oluşturulamadı: %v", err)
+		}
+		if err := os.WriteFile(target, data, 0644); err != nil {
+			return fmt.Errorf("%s kaydedilemedi: %v", rel, err)
+		}
+	}
+	return nil
+}
+
+// mergeFile, template ve partial gibi dosyaları dosya bazında birleştirir
+func (p *Plan) mergeFile(utilDir string, rel string, strategy string) error {
+	incoming := p.Bundle.Files[rel]
+	existing, err := os.ReadFile(filepath.Join(utilDir, filepath.FromSlash(rel)))
+	if os.IsNotExist(err) {
+		p.writes[rel] = incoming
+		p.Changes = append(p.Changes, Change{Path: rel, Action: ActionAdd})
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s okunamadı: %v", rel, err)
+	}
+
+	switch {
+	case bytes.Equal(existing, incoming):
+		p.Changes = append(p.Changes, Change{Path: rel, Action: ActionSame})
+	case strategy == ConflictOverwrite:
+		p.writes[rel] = incoming
+		p.Changes = append(p.Changes, Change{Path: rel, Action: ActionOverwrite})
+	case strategy == ConflictRename:
+		target := p.renamedPath(utilDir, rel)
+		data, err := renameOutput(rel, target, existing, incoming)
+		if err != nil {
+			return err
+		}
+		p.writes[target] = data
+		p.Changes = append(p.Changes, Change{Path: rel, Action: ActionRename, Target: target})
+	default:
+		p.Changes = append(p.Changes, Change{Path: rel, Action: ActionSkip})
+	}
+	return nil
+}
+
+// renamedPath, dosya için mevcut olmayan bir isim bulur. Örn: app.dart.json -> app.dart_1.json
+func (p *Plan) renamedPath(utilDir string, rel string) string {
+	dir, base := path.Split(rel)
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+	if template.IsTemplateFile(base) {
+		stem = template.TemplateName(base)
+	}
+	ext := strings.TrimPrefix(base, stem)
+
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s%s_%d%s", dir, stem, i, ext)
+		if _, taken := p.writes[candidate]; taken {
+			continue
+		}
+		if _, taken := p.Bundle.Files[candidate]; taken {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(utilDir, filepath.FromSlash(candidate))); err == nil {
+			continue
+		}
+		return candidate
+	}
+}
+
+// renameOutput, yeniden adlandırılan template mevcut template ile aynı dosyaya render ediliyorsa
+// çıktı yolunu da aynı ekle değiştirir. Örn: lib/app.dart -> lib/app_1.dart
+func renameOutput(rel string, target string, existing []byte, incoming []byte) ([]byte, error) {
+	if !strings.HasPrefix(rel, "templates/") || !template.IsTemplateFile(rel) {
+		return incoming, nil
+	}
+
+	current, err := template.DecodeTemplate(rel, existing)
+	if err != nil {
+		return nil, fmt.Errorf("%s okunamadı: %v", rel, err)
+	}
+	other, err := template.DecodeTemplate(rel, incoming)
+	if err != nil {
+		return nil, fmt.Errorf("arşivdeki %s okunamadı: %v", rel, err)
+	}
+	if current.Path != other.Path {
+		return incoming, nil
+	}
+
+	suffix := strings.TrimPrefix(template.TemplateName(path.Base(target)), template.TemplateName(path.Base(rel)))
+	ext := path.Ext(other.Path)
+	other.Path = strings.TrimSuffix(other.Path, ext) + suffix + ext
+	return template.EncodeTemplate(target, other)
+}
+
+// mergeConfig, type, paket ve değişken dosyalarını kayıt bazında birleştirir
+func (p *Plan) mergeConfig(utilDir string, rel string, strategy string) error {
+	existing, err := os.ReadFile(filepath.Join(utilDir, rel))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("%s okunamadı: %v", rel, err)
+	}
+	incoming := p.Bundle.Files[rel]
+	first := len(p.Changes)
+
+	var data []byte
+	switch rel {
+	case "template_for.json":
+		var current, other struct {
+			Types []project.TemplateType `json:"types"`
+		}
+		if err := decodeConfig(rel, existing, incoming, &current, &other); err != nil {
+			return err
+		}
+		current.Types = mergeNamed(p, rel, current.Types, other.Types, func(t project.TemplateType) string { return t.Name }, strategy)
+		data, err = json.MarshalIndent(current, "", "  ")
+	case "packages.json":
+		var current, other []project.Package
+		if err := decodeConfig(rel, existing, incoming, &current, &other); err != nil {
+			return err
+		}
+		current = mergeNamed(p, rel, current, other, func(pkg project.Package) string { return pkg.Name }, strategy)
+		data, err = json.MarshalIndent(current, "", "  ")
+	case "variables.json":
+		var current, other struct {
+			Variables []template.Variable `json:"variables"`
+		}
+		if err := decodeConfig(rel, existing, incoming, &current, &other); err != nil {
+			return err
+		}
+		current.Variables = mergeNamed(p, rel, current.Variables, other.Variables, func(v template.Variable) string { return v.Name }, strategy)
+		data, err = json.MarshalIndent(current, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("%s dönüştürme hatası: %v", rel, err)
+	}
+
+	// Sadece eklenen veya değişen kayıt varsa dosya yeniden yazılır
+	for _, c := range p.Changes[first:] {
+		if c.Action == ActionAdd || c.Action == ActionOverwrite {
+			p.writes[rel] = data
+			break
+		}
+	}
+	return nil
+}
+
+// decodeConfig, mevcut ve arşivdeki yapılandırma dosyalarını parse eder. Mevcut dosya yoksa boş kabul edilir
+func decodeConfig(rel string, existing, incoming []byte, current, other interface{}) error {
+	if len(existing) > 0 {
+		if err := json.Unmarshal(existing, current); err != nil {
+			return fmt.Errorf("mevcut %s parse hatası: %v", rel, err)
+		}
+	}
+	if err := json.Unmarshal(incoming, other); err != nil {
+		return fmt.Errorf("arşivdeki %s parse hatası: %v", rel, err)
+	}
+	return nil
+}
+
+// mergeNamed, isimle tanımlanan kayıtları birleştirir ve her kayıt için değişikliği plana ekler
+func mergeNamed[T any](p *Plan, rel string, current, incoming []T, name func(T) string, strategy string) []T {
+	index := make(map[string]int)
+	for i, item := range current {
+		index[name(item)] = i
+	}
+
+	for _, item := range incoming {
+		change := Change{Path: rel, Name: name(item)}
+		i, ok := index[name(item)]
+		switch {
+		case !ok:
+			index[name(item)] = len(current)
+			current = append(current, item)
+			change.Action = ActionAdd
+		case reflect.DeepEqual(current[i], item):
+			change.Action = ActionSame
+		case strategy == ConflictOverwrite:
+			current[i] = item
+			change.Action = ActionOverwrite
+		default:
+			change.Action = ActionSkip
+		}
+		p.Changes = append(p.Changes, change)
+	}
+	return current
+}
+
+func isNamedFile(rel string) bool {
+	for _, name := range namedFiles {
+		if rel == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,231 @@
+package bundle
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SignatureName, arşivin kökündeki imza dosyasının ismidir
+const SignatureName = "manifest.sig"
+
+// TrustedKeysName, güvenilen açık anahtarların tutulduğu template_util dosyasıdır
+const TrustedKeysName = "trusted_keys.json"
+
+// İmza doğrulamasında --allow-unsigned ile geçilebilen durumlar
+var (
+	ErrUnsigned  = errors.New("bundle imzalanmamış")
+	ErrUntrusted = errors.New("bundle güvenilmeyen bir anahtar ile imzalanmış")
+)
+
+// Signature, manifestin ed25519 imzasını ve imzalayan açık anahtarı tutar
+type Signature struct {
+	KeyID     string `json:"key_id"`
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+// TrustedKey, imzalarına güvenilen bir açık anahtardır
+type TrustedKey struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"public_key"`
+}
+
+// KeyID, açık anahtarın kısa kimliğini döndürür
+func KeyID(publicKey ed25519.PublicKey) string {
+	sum := sha256.Sum256(publicKey)
+	return hex.EncodeToString(sum[:8])
+}
+
+// GenerateKey, yeni bir ed25519 anahtar çifti oluşturur ve <dir>/<isim>.key ile <dir>/<isim>.pub dosyalarına yazar.
+// Özel anahtar sadece kullanıcının okuyabileceği izinlerle kaydedilir.
+func GenerateKey(name string, dir string) (string, string, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("anahtar oluşturulamadı: %v", err)
+	}
+
+	privatePath := filepath.Join(dir, name+".key")
+	publicPath := filepath.Join(dir, name+".pub")
+	for _, p := range []string{privatePath, publicPath} {
+		if _, err := os.Stat(p); err == nil {
+			return "", "", fmt.Errorf("anahtar dosyası zaten mevcut: %s", p)
+		}
+	}
+
+	encodedPublic := base64.StdEncoding.EncodeToString(publicKey)
+	if err := os.WriteFile(privatePath, []byte(base64.StdEncoding.EncodeToString(privateKey)+"\n"), 0600); err != nil {
+		return "", "", fmt.Errorf("özel anahtar kaydedilemedi: %v", err)
+	}
+	if err := os.WriteFile(publicPath, []byte(encodedPublic+"\n"), 0644); err != nil {
+		return "", "", fmt.Errorf("açık anahtar kaydedilemedi: %v", err)
+	}
+	return privatePath, encodedPublic, nil
+}
+
+// ReadPrivateKey, GenerateKey ile oluşturulan özel anahtar dosyasını okur
+func ReadPrivateKey(keyPath string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("özel anahtar okunamadı: %v", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("geçersiz özel anahtar: %s", keyPath)
+	}
+	return ed25519.PrivateKey(key), nil
+}
+
+// ParsePublicKey, base64 açık anahtarı veya açık anahtar dosyasının yolunu çözer
+func ParsePublicKey(value string) (ed25519.PublicKey, error) {
+	if data, err := os.ReadFile(value); err == nil {
+		value = string(data)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("geçersiz açık anahtar")
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// Sign, manifesti verilen özel anahtar ile imzalar
+func (b *Bundle) Sign(privateKey ed25519.PrivateKey) error {
+	manifest, err := b.manifestBytes()
+	if err != nil {
+		return err
+	}
+	b.manifestData = manifest
+
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	b.Signature = &Signature{
+		KeyID:     KeyID(publicKey),
+		PublicKey: base64.StdEncoding.EncodeToString(publicKey),
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, manifest)),
+	}
+	return nil
+}
+
+// VerifySignature, manifest imzasını güvenilen anahtarlar ile doğrular ve imzalayan anahtarın ismini döndürür.
+// İmza yoksa ErrUnsigned, anahtar güvenilir değilse ErrUntrusted döner; geçersiz imza her zaman hatadır.
+func (b *Bundle) VerifySignature(trusted []TrustedKey) (string, error) {
+	if b.Signature == nil {
+		return "", ErrUnsigned
+	}
+
+	publicKey, err := ParsePublicKey(b.Signature.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("imzadaki açık anahtar geçersiz: %v", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(b.Signature.Signature)
+	if err != nil {
+		return "", fmt.Errorf("imza çözülemedi: %v", err)
+	}
+	manifest, err := b.manifestBytes()
+	if err != nil {
+		return "", err
+	}
+	if !ed25519.Verify(publicKey, manifest, signature) {
+		return "", fmt.Errorf("imza geçersiz, manifest değiştirilmiş olabilir")
+	}
+
+	for _, key := range trusted {
+		if key.PublicKey == b.Signature.PublicKey {
+			return key.Name, nil
+		}
+	}
+	return "", fmt.Errorf("%w (anahtar %s)", ErrUntrusted, KeyID(publicKey))
+}
+
+// LoadTrustedKeys, template_util/trusted_keys.json dosyasındaki güvenilen anahtarları döndürür
+func LoadTrustedKeys() ([]TrustedKey, error) {
+	utilDir, err := UtilDir()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(utilDir, TrustedKeysName))
+	if os.IsNotExist(err) {
+		return []TrustedKey{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("güvenilen anahtarlar okunamadı: %v", err)
+	}
+
+	var result struct {
+		Keys []TrustedKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("güvenilen anahtarlar JSON parse hatası: %v", err)
+	}
+	return result.Keys, nil
+}
+
+// saveTrustedKeys, güvenilen anahtarları template_util/trusted_keys.json dosyasına yazar
+func saveTrustedKeys(keys []TrustedKey) error {
+	utilDir, err := UtilDir()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(struct {
+		Keys []TrustedKey `json:"keys"`
+	}{Keys: keys}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("JSON dönüştürme hatası: %v", err)
+	}
+
+	if err := os.MkdirAll(utilDir, 0755); err != nil {
+		return fmt.Errorf("template util klasörü oluşturulamadı: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(utilDir, TrustedKeysName), data, 0644); err != nil {
+		return fmt.Errorf("güvenilen anahtarlar kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+// TrustKey, açık anahtarı verilen isimle güvenilen anahtarlara ekler
+func TrustKey(name string, publicKey ed25519.PublicKey) error {
+	keys, err := LoadTrustedKeys()
+	if err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(publicKey)
+	for _, key := range keys {
+		if key.Name == name {
+			return fmt.Errorf("anahtar ismi zaten mevcut: %s", name)
+		}
+		if key.PublicKey == encoded {
+			return fmt.Errorf("anahtar zaten %s ismiyle güvenilir", key.Name)
+		}
+	}
+
+	return saveTrustedKeys(append(keys, TrustedKey{Name: name, PublicKey: encoded}))
+}
+
+// UntrustKey, anahtarı güvenilen anahtarlardan siler
+func UntrustKey(name string) error {
+	keys, err := LoadTrustedKeys()
+	if err != nil {
+		return err
+	}
+
+	remaining := []TrustedKey{}
+	for _, key := range keys {
+		if key.Name != name {
+			remaining = append(remaining, key)
+		}
+	}
+	if len(remaining) == len(keys) {
+		return fmt.Errorf("anahtar bulunamadı: %s", name)
+	}
+	return saveTrustedKeys(remaining)
+}
@@ -0,0 +1,73 @@
+package firebase
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/pubspec"
+)
+
+// OptionsFile, flutterfire configure'un oluşturduğu Firebase yapılandırma dosyasıdır
+const OptionsFile = "lib/firebase_options.dart"
+
+// StubMarker, FIREBASE type'ı ile oluşturulan taslak yapılandırma dosyasını işaretler.
+// flutterfire configure dosyanın üzerine yazdığında işaret kaybolur
+const StubMarker = "flutter_assist firebase stub"
+
+// Placeholder, taslakta değeri verilmeyen alanların değeridir
+const Placeholder = "TODO"
+
+var (
+	// optionsBlock, platform yapılandırmasının başladığı satır: "static const FirebaseOptions android = FirebaseOptions("
+	optionsBlock = regexp.MustCompile(`static const FirebaseOptions (\w+)\s*=`)
+	// placeholderField, değeri TODO ile başlayan alan: "apiKey: 'TODO',"
+	placeholderField = regexp.MustCompile(`^\s*(\w+):\s*'` + Placeholder + `[^']*'`)
+)
+
+// Status, projedeki Firebase yapılandırmasının durumunu tutar
+type Status struct {
+	// Used, projede firebase_core bağımlılığı veya yapılandırma dosyası varsa true olur
+	Used bool
+	// Exists, yapılandırma dosyası varsa true olur
+	Exists bool
+	// Stub, yapılandırma dosyası hala flutter_assist taslağı ise true olur
+	Stub bool
+	// Placeholders, taslakta değeri girilmemiş alanlar: "android.apiKey"
+	Placeholders []string
+}
+
+// Check, projenin Firebase yapılandırma dosyasının taslak olup olmadığını kontrol eder
+func Check(root string) (*Status, error) {
+	used, err := pubspec.HasDependency(filepath.Join(root, pubspec.FileName), "firebase_core")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(OptionsFile)))
+	if os.IsNotExist(err) {
+		return &Status{Used: used}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s okunamadı: %v", OptionsFile, err)
+	}
+
+	status := &Status{Used: true, Exists: true, Stub: strings.Contains(string(data), StubMarker)}
+	if !status.Stub {
+		return status, nil
+	}
+
+	platform := ""
+	for _, line := range strings.Split(string(data), "\n") {
+		if m := optionsBlock.FindStringSubmatch(line); m != nil {
+			platform = m[1]
+			continue
+		}
+		if m := placeholderField.FindStringSubmatch(line); m != nil && platform != "" {
+			status.Placeholders = append(status.Placeholders, platform+"."+m[1])
+		}
+	}
+	return status, nil
+}
@@ -0,0 +1,378 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/pubspec"
+	"github.com/burak/flutter_assist/internal/render"
+)
+
+// AssetsKind, asset generator'ının template_util/generators altındaki klasörüdür
+const AssetsKind = "assets"
+
+// AssetsDir, projedeki asset'lerin taranan klasörüdür
+const AssetsDir = "assets"
+
+// AssetsMarker, asset generator'ının her çalıştırmada yeniden yazdığı dosyaları işaretler
+const AssetsMarker = "flutter_assist gen assets"
+
+// Asset kategorileri, her biri template'te ayrı bir sınıftır
+const (
+	AssetImages = "images"
+	AssetIcons  = "icons"
+	AssetLottie = "lottie"
+	AssetFonts  = "fonts"
+)
+
+// Asset template'lerine verilen değerler, her biri kategorideki sabitlerdir:
+// "  static const String logo = 'assets/images/logo.png';"
+const (
+	ImagesKey = "IMAGES"
+	IconsKey  = "ICONS"
+	LottieKey = "LOTTIE"
+	FontsKey  = "FONTS"
+)
+
+// assetCategories, kategorilerin template anahtarları
+var assetCategories = map[string]string{
+	AssetImages: ImagesKey,
+	AssetIcons:  IconsKey,
+	AssetLottie: LottieKey,
+	AssetFonts:  FontsKey,
+}
+
+// assetFolders, assets/ altındaki klasör isimlerinin kategorileri. Klasör ismi uzantıdan önceliklidir
+var assetFolders = map[string]string{
+	"images":     AssetImages,
+	"image":      AssetImages,
+	"icons":      AssetIcons,
+	"icon":       AssetIcons,
+	"lottie":     AssetLottie,
+	"animations": AssetLottie,
+	"fonts":      AssetFonts,
+}
+
+// assetExtensions, dosya uzantılarının kategorileri
+var assetExtensions = map[string]string{
+	".png":    AssetImages,
+	".jpg":    AssetImages,
+	".jpeg":   AssetImages,
+	".gif":    AssetImages,
+	".webp":   AssetImages,
+	".bmp":    AssetImages,
+	".svg":    AssetIcons,
+	".lottie": AssetLottie,
+	".ttf":    AssetFonts,
+	".otf":    AssetFonts,
+}
+
+// categoryExtensions, klasörden belirlenen kategoride kabul edilen uzantılar.
+// Örneğin lottie klasöründeki .json dosyaları animasyondur
+var categoryExtensions = map[string][]string{
+	AssetImages: {".png", ".jpg", ".jpeg", ".gif", ".webp", ".bmp", ".svg"},
+	AssetIcons:  {".png", ".jpg", ".jpeg", ".gif", ".webp", ".bmp", ".svg"},
+	AssetLottie: {".json", ".lottie"},
+	AssetFonts:  {".ttf", ".otf"},
+}
+
+// resolutionDir, Flutter'ın çözünürlüğe göre seçtiği varyant klasörleri: 2.0x, 3.0x
+var resolutionDir = regexp.MustCompile(`^\d+(\.\d+)?x$`)
+
+// fontWeights, font dosyası ismindeki stil kelimelerinin ağırlıkları: Roboto-SemiBold.ttf -> 600
+var fontWeights = []struct {
+	Style  string
+	Weight int
+}{
+	{"ExtraLight", 200}, {"UltraLight", 200}, {"ExtraBold", 800}, {"UltraBold", 800},
+	{"SemiBold", 600}, {"DemiBold", 600}, {"Thin", 100}, {"Light", 300}, {"Regular", 400},
+	{"Medium", 500}, {"Bold", 700}, {"Black", 900}, {"Heavy", 900},
+}
+
+// Asset, assets/ altında bulunan ve sabit olarak üretilen bir dosyadır
+type Asset struct {
+	// Category, asset'in sınıfı: images, icons, lottie veya fonts
+	Category string
+	// Name, sabitin ismi: "onboardingStep1"
+	Name string
+	// Path, proje köküne göre yolu: "assets/images/onboarding/step_1.png"
+	Path string
+}
+
+// AssetsResult, asset generator'ının sonucunu tutar
+type AssetsResult struct {
+	Assets []Asset
+	// Skipped, kategorisi belirlenemeyen dosyalar
+	Skipped []string
+	// Changed, içeriği değişen (dry-run'da değişecek) dosyalar
+	Changed []string
+	// Registered, pubspec.yaml'a eklenen (dry-run'da eklenecek) asset klasörleri
+	Registered []string
+	// Removed, artık bulunmadığı için pubspec.yaml'dan silinen (dry-run'da silinecek) asset yolları
+	Removed []string
+	// Fonts, pubspec.yaml'a eklenen veya dosyaları güncellenen (dry-run'da güncellenecek) font aileleri
+	Fonts []string
+	// RemovedFonts, dosyaları silindiği için pubspec.yaml'dan silinen (dry-run'da silinecek) font aileleri
+	RemovedFonts []string
+}
+
+// ScanAssets, assets/ altındaki dosyaları kategorilerine ayırır. Kategori önce üst klasörün
+// isminden (images, icons, lottie, fonts), yoksa dosya uzantısından belirlenir.
+// Gizli dosyalar ve 2.0x gibi çözünürlük varyantları atlanır.
+func ScanAssets(root string) ([]Asset, []string, error) {
+	assetsDir := filepath.Join(root, AssetsDir)
+	if _, err := os.Stat(assetsDir); err != nil {
+		return nil, nil, fmt.Errorf("%s klasörü bulunamadı", AssetsDir)
+	}
+
+	var assets []Asset
+	var skipped []string
+	err := filepath.WalkDir(assetsDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(d.Name(), ".") || d.IsDir() && resolutionDir.MatchString(d.Name()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		category, name := classifyAsset(strings.TrimPrefix(relPath, AssetsDir+"/"))
+		if category == "" {
+			skipped = append(skipped, relPath)
+			return nil
+		}
+		assets = append(assets, Asset{Category: category, Name: name, Path: relPath})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s klasörü okunamadı: %v", AssetsDir, err)
+	}
+
+	sort.Slice(assets, func(i, j int) bool {
+		return assets[i].Path < assets[j].Path
+	})
+	return uniqueAssetNames(assets), skipped, nil
+}
+
+// classifyAsset, assets/ klasörüne göre yolu verilen dosyanın kategorisini ve sabit isminin
+// kaynağını döndürür: images/onboarding/step_1.png -> images, onboarding/step_1
+func classifyAsset(relPath string) (string, string) {
+	ext := strings.ToLower(path.Ext(relPath))
+	base := strings.TrimSuffix(relPath, path.Ext(relPath))
+
+	folder, rest, nested := strings.Cut(base, "/")
+	if category, ok := assetFolders[strings.ToLower(folder)]; ok && nested {
+		for _, allowed := range categoryExtensions[category] {
+			if ext == allowed {
+				return category, rest
+			}
+		}
+		return "", ""
+	}
+	return assetExtensions[ext], base
+}
+
+// uniqueAssetNames, yolları sabit isimlerine çevirir. Aynı kategoride çakışan isimlere
+// uzantı, yine çakışırsa sıra numarası eklenir: logo.png, logo.svg -> logoPng, logoSvg
+func uniqueAssetNames(assets []Asset) []Asset {
+	counts := make(map[string]int)
+	for i := range assets {
+		assets[i].Name = propertyName(assets[i].Name)
+		counts[assets[i].Category+"/"+assets[i].Name]++
+	}
+
+	used := make(map[string]bool)
+	for i := range assets {
+		key := assets[i].Category + "/" + assets[i].Name
+		if counts[key] > 1 {
+			ext, _ := render.ConvertCase(strings.TrimPrefix(path.Ext(assets[i].Path), "."), "pascal")
+			assets[i].Name += ext
+		}
+		name := assets[i].Name
+		for n := 2; used[assets[i].Category+"/"+name]; n++ {
+			name = fmt.Sprintf("%s%d", assets[i].Name, n)
+		}
+		assets[i].Name = name
+		used[assets[i].Category+"/"+name] = true
+	}
+	return assets
+}
+
+// fontFamilies, font dosyalarını aileye göre gruplar. Aile ismi dosya isminde "-" öncesidir,
+// ağırlık ve stil sonrasından çıkarılır: Roboto-BoldItalic.ttf -> Roboto, 700, italic
+func fontFamilies(assets []Asset) []pubspec.Font {
+	var fonts []pubspec.Font
+	index := make(map[string]int)
+	for _, asset := range assets {
+		if asset.Category != AssetFonts {
+			continue
+		}
+
+		base := strings.TrimSuffix(path.Base(asset.Path), path.Ext(asset.Path))
+		family, style, _ := strings.Cut(base, "-")
+		file := pubspec.FontFile{Asset: asset.Path, Italic: strings.Contains(style, "Italic")}
+		for _, w := range fontWeights {
+			if strings.Contains(style, w.Style) {
+				file.Weight = w.Weight
+				break
+			}
+		}
+
+		i, ok := index[family]
+		if !ok {
+			i = len(fonts)
+			index[family] = i
+			fonts = append(fonts, pubspec.Font{Family: family})
+		}
+		fonts[i].Files = append(fonts[i].Files, file)
+	}
+	return fonts
+}
+
+// assetValues, asset template'lerinde kullanılan değerleri üretir. Font sınıfında
+// dosya yolları yerine pubspec.yaml'a kaydedilen aile isimleri bulunur.
+func assetValues(assets []Asset, fonts []pubspec.Font) map[string]string {
+	lines := make(map[string][]string)
+	for _, asset := range assets {
+		if asset.Category == AssetFonts {
+			continue
+		}
+		lines[asset.Category] = append(lines[asset.Category], fmt.Sprintf("  static const String %s = '%s';", asset.Name, asset.Path))
+	}
+	for _, font := range fonts {
+		lines[AssetFonts] = append(lines[AssetFonts], fmt.Sprintf("  static const String %s = '%s';", propertyName(font.Family), font.Family))
+	}
+
+	values := map[string]string{NameKey: "app_assets"}
+	for category, key := range assetCategories {
+		values[key] = strings.Join(lines[category], "\n")
+	}
+	return values
+}
+
+// assetDirs, kategorisi belirlenen asset'lerin bulunduğu ve pubspec.yaml'a kaydedilecek klasörler.
+// Flutter alt klasörleri otomatik eklemediği için her klasör ayrı kaydedilir, fontlar fonts bölümündedir.
+// Diğer dosyaların pakete girmemesi için doğrudan assets/ altındaki dosyalar tek tek kaydedilir.
+func assetDirs(assets []Asset) []string {
+	var dirs []string
+	for _, asset := range assets {
+		switch {
+		case asset.Category == AssetFonts:
+		case path.Dir(asset.Path) == AssetsDir:
+			dirs = append(dirs, asset.Path)
+		default:
+			dirs = append(dirs, path.Dir(asset.Path)+"/")
+		}
+	}
+	sort.Strings(dirs)
+	return uniqueSorted(dirs)
+}
+
+// staleAssets, pubspec.yaml'da assets/ altında kayıtlı olup artık bulunmayan yolları döndürür
+func staleAssets(root string, registered []string) []string {
+	var stale []string
+	for _, asset := range registered {
+		if !strings.HasPrefix(asset, AssetsDir+"/") {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(asset))); os.IsNotExist(err) {
+			stale = append(stale, asset)
+		}
+	}
+	return stale
+}
+
+// GenerateAssets, assets/ klasörünü tarayıp generators/assets template'leri ile sabit sınıflarını üretir,
+// asset klasörlerini ve font ailelerini pubspec.yaml'a kaydeder. Silinen dosyalar sınıftan ve
+// pubspec.yaml'dan kaldırılır; üretilen dosyalar WriteGenerated ile her çalıştırmada baştan yazılır.
+func GenerateAssets(opts Options) (*AssetsResult, error) {
+	p, err := OpenProject(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Require(opts.Requires...); err != nil {
+		return nil, err
+	}
+
+	assets, skipped, err := ScanAssets(p.Root)
+	if err != nil {
+		return nil, err
+	}
+	fonts := fontFamilies(assets)
+
+	files, err := p.RenderEach(AssetsKind, []map[string]string{assetValues(assets, fonts)}, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+
+	// Asset'i olmayan kategorilerin sınıfları boş gövdeyle yazılır
+	for i := range files {
+		files[i].Content = strings.ReplaceAll(files[i].Content, "{\n\n}", "{}")
+	}
+
+	result := &AssetsResult{Assets: assets, Skipped: skipped}
+	if result.Changed, err = p.WriteGenerated(files, AssetsMarker, opts); err != nil {
+		return nil, err
+	}
+
+	pubspecPath := filepath.Join(p.Root, pubspec.FileName)
+	registered, err := pubspec.Assets(pubspecPath)
+	if err != nil {
+		return nil, err
+	}
+	// Dry-run'da değişiklikler pubspec.yaml'ın geçici bir kopyasına uygulanır; gerçek çalıştırmada
+	// yapılacak asset ve font değişiklikleri dosyaya dokunulmadan raporlanır
+	if opts.DryRun {
+		preview, err := previewCopy(pubspecPath)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(preview)
+		pubspecPath = preview
+	}
+
+	if result.Removed, err = pubspec.RemoveAssets(pubspecPath, staleAssets(p.Root, registered)); err != nil {
+		return nil, err
+	}
+	if result.Registered, err = pubspec.AddAssets(pubspecPath, assetDirs(assets)); err != nil {
+		return nil, err
+	}
+	if result.Fonts, result.RemovedFonts, err = pubspec.SyncFonts(pubspecPath, fonts, AssetsDir); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// previewCopy, dosyanın geçici bir kopyasını oluşturup yolunu döndürür
+func previewCopy(filePath string) (string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("%s okunamadı: %v", filepath.Base(filePath), err)
+	}
+	tmp, err := os.CreateTemp("", "flutter_assist-*-"+filepath.Base(filePath))
+	if err != nil {
+		return "", fmt.Errorf("geçici dosya oluşturulamadı: %v", err)
+	}
+	defer tmp.Close()
+	if _, err := tmp.Write(data); err != nil {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("geçici dosya yazılamadı: %v", err)
+	}
+	return tmp.Name(), nil
+}
@@ -0,0 +1,512 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DependencyKind, bağımlılık kayıt dosyasının template_util/generators altındaki klasörüdür
+const DependencyKind = "dependency"
+
+// DependencyFile, bağımlılık kayıt dosyasının proje köküne göre yoludur
+const DependencyFile = "lib/core/dependency/app_dependency.dart"
+
+// RegistrationsKey, bağımlılık template'inde get_it kayıtlarıdır. IMPORTS ile birlikte işaretli bölümlerde kullanılır:
+// "    getIt.registerLazySingleton<IHomeService>(HomeService.new);"
+const RegistrationsKey = "REGISTRATIONS"
+
+// dependencyPackages, üretilen kayıt dosyasının ihtiyaç duyduğu paketler
+var dependencyPackages = []string{"get_it"}
+
+// dependencySuffixes, isim kuralı ile bulunan sınıfların dosya ve sınıf sonekleri
+var dependencySuffixes = []string{"Service", "Repository"}
+
+// Kayıt türleri
+const (
+	LifetimeSingleton = "singleton"
+	LifetimeFactory   = "factory"
+)
+
+var (
+	// injectComment, sınıfı kayda ekleyen yorum: "// @inject" veya "// @inject factory"
+	injectComment = regexp.MustCompile(`^\s*//\s*@inject(?:\s+(singleton|factory))?\s*$`)
+	// classHeader, sınıf tanımı ve değiştiricileri: "abstract interface class IHomeService"
+	classHeader = regexp.MustCompile(`^\s*((?:(?:abstract|base|final|sealed|interface|mixin)\s+)*)class\s+([A-Z]\w*)\b`)
+	// implementsClause, sınıfın uyguladığı ilk arayüz
+	implementsClause = regexp.MustCompile(`\bimplements\s+([A-Z]\w*)`)
+	// manualRegistration, kayıt dosyasında elle yazılmış get_it kaydı: "getIt.registerSingleton<IApiClient>("
+	manualRegistration = regexp.MustCompile(`\.register\w*<([A-Z]\w*)>`)
+)
+
+// Dependency, get_it'e kaydedilecek bir sınıftır
+type Dependency struct {
+	// Class, somut sınıfın ismi
+	Class string
+	// Type, kaydın tipi: sınıf bir arayüz uyguluyorsa arayüz, yoksa sınıfın kendisi
+	Type string
+	// File, sınıfın tanımlandığı dosyanın lib/ klasörüne göre yolu
+	File string
+	// Lifetime, singleton (registerLazySingleton) veya factory (registerFactory)
+	Lifetime string
+	// Params, constructor parametreleri
+	Params []Param
+}
+
+// Param, constructor parametresidir
+type Param struct {
+	Name     string
+	Type     string
+	Named    bool
+	Required bool
+}
+
+// DependencyResult, bağımlılık generator'ının sonucunu tutar
+type DependencyResult struct {
+	Dependencies []Dependency
+	// Skipped, bir bağımlılığı getIt() ile verilemediği için atlanan sınıflar ve sebepleri
+	Skipped map[string]string
+	// Changed, içeriği değişen (dry-run'da değişecek) dosyalar
+	Changed []string
+}
+
+// ScanDependencies, lib/ altındaki *_service.dart ve *_repository.dart dosyalarında Service veya Repository
+// ile biten somut sınıfları ve "// @inject" ile işaretlenen sınıfları bulur. Sınıfların ve arayüzlerin
+// tanımlandığı dosyalar da döndürülür.
+func ScanDependencies(root string) ([]Dependency, map[string]string, error) {
+	libDir := filepath.Join(root, "lib")
+	var dependencies []Dependency
+	declared := make(map[string]string)
+	err := filepath.WalkDir(libDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".dart") || strings.HasSuffix(d.Name(), ".g.dart") {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(libDir, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if "lib/"+relPath == DependencyFile {
+			return nil
+		}
+
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		found, classes := scanDependencyFile(relPath, string(data))
+		dependencies = append(dependencies, found...)
+		for _, class := range classes {
+			declared[class] = relPath
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("lib klasörü okunamadı: %v", err)
+	}
+
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].File != dependencies[j].File {
+			return dependencies[i].File < dependencies[j].File
+		}
+		return dependencies[i].Class < dependencies[j].Class
+	})
+	return dependencies, declared, nil
+}
+
+// scanDependencyFile, bir dart dosyasındaki kaydedilecek sınıfları ve tanımlı tüm sınıf isimlerini döndürür
+func scanDependencyFile(relPath string, content string) ([]Dependency, []string) {
+	conventional := false
+	for _, suffix := range dependencySuffixes {
+		if strings.HasSuffix(relPath, "_"+strings.ToLower(suffix)+".dart") {
+			conventional = true
+		}
+	}
+
+	lines := strings.Split(content, "\n")
+	var dependencies []Dependency
+	var classes []string
+	marked, lifetime := false, ""
+	for i, line := range lines {
+		if m := injectComment.FindStringSubmatch(line); m != nil {
+			marked, lifetime = true, m[1]
+			continue
+		}
+		m := classHeader.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		class := m[2]
+		classes = append(classes, class)
+
+		abstract := strings.Contains(m[1], "abstract") || strings.Contains(m[1], "interface") ||
+			strings.Contains(m[1], "sealed") || strings.Contains(m[1], "mixin")
+		if !abstract && (marked || conventional && hasDependencySuffix(class)) {
+			header, body := classParts(lines[i:])
+			dependency := Dependency{Class: class, Type: class, File: relPath, Lifetime: LifetimeSingleton}
+			if lifetime != "" {
+				dependency.Lifetime = lifetime
+			}
+			if im := implementsClause.FindStringSubmatch(header); im != nil {
+				dependency.Type = im[1]
+			}
+			dependency.Params = constructorParams(class, body)
+			dependencies = append(dependencies, dependency)
+		}
+		marked, lifetime = false, ""
+	}
+	return dependencies, classes
+}
+
+// hasDependencySuffix, sınıf isminin Service veya Repository ile bitip bitmediğini döndürür
+func hasDependencySuffix(class string) bool {
+	for _, suffix := range dependencySuffixes {
+		if strings.HasSuffix(class, suffix) && class != suffix {
+			return true
+		}
+	}
+	return false
+}
+
+// classParts, sınıf tanımından başlayan satırlardan sınıf başlığını ({ öncesi) ve gövdesini döndürür
+func classParts(lines []string) (string, string) {
+	text := strings.Join(lines, "\n")
+	open := strings.Index(text, "{")
+	if open == -1 {
+		return text, ""
+	}
+	end := matchingBracket(text, open)
+	if end == -1 {
+		end = len(text)
+	}
+	return text[:open], text[open+1 : end]
+}
+
+// matchingBracket, verilen konumdaki açılış parantezini kapatan parantezin konumunu döndürür. Bulunamazsa -1 döner
+func matchingBracket(text string, open int) int {
+	pairs := map[byte]byte{'(': ')', '{': '}', '[': ']'}
+	var stack []byte
+	for i := open; i < len(text); i++ {
+		switch c := text[i]; c {
+		case '(', '{', '[':
+			stack = append(stack, pairs[c])
+		case ')', '}', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != c {
+				return -1
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// constructorParams, sınıfın isimsiz constructor'ının parametrelerini döndürür.
+// this.x ile tanımlanan parametrelerin tipi sınıftaki alandan bulunur.
+func constructorParams(class string, body string) []Param {
+	constructor := regexp.MustCompile(`(?:^|[\s;}])(?:const\s+)?` + class + `\s*\(`)
+	loc := constructor.FindStringIndex(body)
+	if loc == nil {
+		return nil
+	}
+	open := loc[1] - 1
+	end := matchingBracket(body, open)
+	if end == -1 {
+		return nil
+	}
+
+	var params []Param
+	for _, part := range splitParams(body[open+1 : end]) {
+		param := Param{Named: part.named, Required: !part.optional}
+		text := strings.TrimSpace(part.text)
+		if before, _, ok := strings.Cut(text, "="); ok {
+			text = strings.TrimSpace(before)
+			param.Required = false
+		}
+		if part.named {
+			required := strings.HasPrefix(text, "required ")
+			text = strings.TrimSpace(strings.TrimPrefix(text, "required "))
+			param.Required = required
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		name := fields[len(fields)-1]
+		switch {
+		case strings.HasPrefix(name, "super."):
+			continue
+		case strings.HasPrefix(name, "this."):
+			param.Name = strings.TrimPrefix(name, "this.")
+			param.Type = fieldType(body, param.Name)
+		default:
+			param.Name = name
+			param.Type = strings.Join(fields[:len(fields)-1], " ")
+		}
+		if strings.HasSuffix(param.Type, "?") {
+			param.Type = strings.TrimSuffix(param.Type, "?")
+			if !part.named {
+				param.Required = false
+			}
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+// paramPart, constructor parametre listesindeki bir parametredir
+type paramPart struct {
+	text     string
+	named    bool
+	optional bool
+}
+
+// splitParams, parametre listesini en üst seviyedeki virgüllerden böler. { } içindekiler isimli,
+// [ ] içindekiler opsiyonel parametrelerdir
+func splitParams(list string) []paramPart {
+	var parts []paramPart
+	depth, named, optional := 0, false, false
+	start := 0
+	flush := func(end int) {
+		if text := strings.TrimSpace(list[start:end]); text != "" {
+			parts = append(parts, paramPart{text: text, named: named, optional: optional})
+		}
+	}
+	for i := 0; i < len(list); i++ {
+		switch list[i] {
+		case '{', '[':
+			if depth == 0 {
+				flush(i)
+				named, optional = list[i] == '{', list[i] == '['
+				start = i + 1
+				continue
+			}
+			depth++
+		case '}', ']':
+			if depth == 0 {
+				flush(i)
+				start = i + 1
+				continue
+			}
+			depth--
+		case '(', '<':
+			depth++
+		case ')', '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				flush(i)
+				start = i + 1
+			}
+		}
+	}
+	flush(len(list))
+	return parts
+}
+
+// fieldType, sınıf gövdesindeki alanın tipini döndürür: "final IHomeService service;" -> IHomeService
+func fieldType(body string, name string) string {
+	field := regexp.MustCompile(`(?m)^\s*(?:late\s+)?(?:final\s+)?([A-Z][\w<>, ?]*?)\s+` + regexp.QuoteMeta(name) + `\s*[;=]`)
+	if m := field.FindStringSubmatch(body); m != nil {
+		return strings.TrimSpace(m[1])
+	}
+	return ""
+}
+
+// manualRegistrations, kayıt dosyasında işaretli bölümlerin dışında elle kaydedilen tipleri döndürür
+func manualRegistrations(content string) []string {
+	var types []string
+	inRegion := false
+	for _, line := range strings.Split(content, "\n") {
+		if m := regionMarker.FindStringSubmatch(line); m != nil {
+			inRegion = m[1] == RegionBegin
+			continue
+		}
+		if inRegion {
+			continue
+		}
+		for _, m := range manualRegistration.FindAllStringSubmatch(line, -1) {
+			types = append(types, m[1])
+		}
+	}
+	return types
+}
+
+// resolveDependencies, constructor'larındaki zorunlu bir parametre kayıtlı bir tipte olmayan sınıfları
+// ve kayıtlı olmayan bir pozisyonel parametreden sonra kayıtlı tipte pozisyonel parametre alan sınıfları
+// ayıklar. manual, elle kaydedilen tiplerdir; bu tipleri uygulayan sınıflar da atlanır. Bir sınıfın atlanması ona bağlı sınıfları da atlatacağı için
+// değişiklik kalmayana kadar tekrarlanır.
+func resolveDependencies(dependencies []Dependency, manual []string) ([]Dependency, map[string]string, error) {
+	types := make(map[string]string)
+	for _, typ := range manual {
+		types[typ] = ""
+	}
+	skipped := make(map[string]string)
+	for _, dependency := range dependencies {
+		if other, ok := types[dependency.Type]; ok {
+			if other == "" {
+				skipped[dependency.Class] = fmt.Sprintf("%s tipi %s içinde elle kaydedilmiş", dependency.Type, DependencyFile)
+				continue
+			}
+			return nil, nil, fmt.Errorf("%s tipi iki sınıf için kaydedilemez: %s ve %s", dependency.Type, other, dependency.Class)
+		}
+		types[dependency.Type] = dependency.Class
+	}
+
+	for {
+		removed := false
+		for _, dependency := range dependencies {
+			if _, ok := skipped[dependency.Class]; ok {
+				continue
+			}
+			// gap, kayıtlı olmayan bir opsiyonel pozisyonel parametre geçildiğinde atlanan parametredir.
+			// Sonraki pozisyonel parametrelere getIt() verilemez, argümanlar yanlış sıraya kayar.
+			var gap *Param
+			for i, param := range dependency.Params {
+				class, ok := types[param.Type]
+				_, isSkipped := skipped[class]
+				resolvable := ok && !isSkipped
+				if param.Required && !resolvable {
+					skipped[dependency.Class] = fmt.Sprintf("%s parametresinin tipi (%s) kayıtlı değil", param.Name, param.Type)
+					removed = true
+					break
+				}
+				if param.Named {
+					continue
+				}
+				if !resolvable {
+					if gap == nil {
+						gap = &dependency.Params[i]
+					}
+					continue
+				}
+				if gap != nil {
+					skipped[dependency.Class] = fmt.Sprintf("%s parametresinden önceki %s parametresinin tipi (%s) kayıtlı değil, sınıfı elle kaydedin", param.Name, gap.Name, gap.Type)
+					removed = true
+					break
+				}
+			}
+		}
+		if !removed {
+			break
+		}
+	}
+
+	var resolved []Dependency
+	for _, dependency := range dependencies {
+		if _, ok := skipped[dependency.Class]; !ok {
+			resolved = append(resolved, dependency)
+		}
+	}
+	return resolved, skipped, nil
+}
+
+// registration, bağımlılığın get_it kaydını üretir. Kayıtlı tipteki parametreler getIt() ile verilir,
+// kayıtlı olmayan bir pozisyonel parametreden sonraki pozisyonel parametreler verilmez
+func registration(dependency Dependency, registered map[string]bool) string {
+	var args []string
+	positional := true
+	for _, param := range dependency.Params {
+		if !registered[param.Type] {
+			// Atlanan pozisyonel parametreden sonraki argümanlar yanlış parametreye verilirdi
+			if !param.Named {
+				positional = false
+			}
+			continue
+		}
+		if !param.Named && !positional {
+			continue
+		}
+		if param.Named {
+			args = append(args, param.Name+": getIt()")
+		} else {
+			args = append(args, "getIt()")
+		}
+	}
+
+	factory := dependency.Class + ".new"
+	if len(args) > 0 {
+		factory = fmt.Sprintf("() => %s(%s)", dependency.Class, strings.Join(args, ", "))
+	}
+	method := "registerLazySingleton"
+	if dependency.Lifetime == LifetimeFactory {
+		method = "registerFactory"
+	}
+	return fmt.Sprintf("    getIt.%s<%s>(%s);", method, dependency.Type, factory)
+}
+
+// dependencyValues, bağımlılık template'inde kullanılan değerleri üretir
+func dependencyValues(projectName string, dependencies []Dependency, manual []string, declared map[string]string) map[string]string {
+	registered := make(map[string]bool)
+	for _, typ := range manual {
+		registered[typ] = true
+	}
+	for _, dependency := range dependencies {
+		registered[dependency.Type] = true
+	}
+
+	var imports, registrations []string
+	for _, dependency := range dependencies {
+		for _, class := range []string{dependency.Class, dependency.Type} {
+			if file, ok := declared[class]; ok {
+				imports = append(imports, fmt.Sprintf("import 'package:%s/%s';", projectName, file))
+			}
+		}
+		registrations = append(registrations, registration(dependency, registered))
+	}
+	sort.Strings(imports)
+
+	return map[string]string{
+		NameKey:          "app_dependency",
+		ImportsKey:       strings.Join(uniqueSorted(imports), "\n"),
+		RegistrationsKey: strings.Join(registrations, "\n"),
+	}
+}
+
+// GenerateDependencies, lib/ altındaki servis ve repository sınıflarını tarar ve generators/dependency
+// template'i ile get_it kayıt dosyasını üretir. Dosya mevcutsa sadece "// flutter_assist:begin" ile
+// işaretlenen bölümler güncellenir, elle yazılan kısımlar korunur.
+func GenerateDependencies(opts Options) (*DependencyResult, error) {
+	p, err := OpenProject(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Require(append(opts.Requires, dependencyPackages...)...); err != nil {
+		return nil, err
+	}
+
+	found, declared, err := ScanDependencies(p.Root)
+	if err != nil {
+		return nil, err
+	}
+	var manual []string
+	if existing, err := os.ReadFile(filepath.Join(p.Root, filepath.FromSlash(DependencyFile))); err == nil {
+		manual = manualRegistrations(string(existing))
+	}
+	dependencies, skipped, err := resolveDependencies(found, manual)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := p.RenderEach(DependencyKind, []map[string]string{dependencyValues(p.Name, dependencies, manual, declared)}, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DependencyResult{Dependencies: dependencies, Skipped: skipped}
+	if result.Changed, err = p.WriteRegions(files, opts); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,357 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// FlavorsKind, flavor generator'ının template_util/generators altındaki klasörüdür.
+// entry alt klasöründeki template'ler her flavor için, config alt klasöründekiler bir kez render edilir
+const FlavorsKind = "flavors"
+
+const (
+	flavorEntryKind  = FlavorsKind + "/entry"
+	flavorConfigKind = FlavorsKind + "/config"
+)
+
+// FlavorsFileName, proje kökünde flavor'ları ve değerlerini tanımlayan dosyadır
+const FlavorsFileName = "flavors.json"
+
+// FlavorsMarker, flavor generator'ının her çalıştırmada yeniden yazdığı dosyaları işaretler
+const FlavorsMarker = "flutter_assist gen flavors"
+
+// FlavorKey, entry template'lerinde ve .env dosyalarında flavor'ın ismidir.
+// Flavor değerlerinde {FLAVOR} ile kullanılabilir: "https://{FLAVOR}.api.example.com"
+const FlavorKey = "FLAVOR"
+
+// Flavor template'lerine verilen değerler
+const (
+	// FlavorsListKey, AppFlavor enum değerleri: "  dev,"
+	FlavorsListKey = "FLAVORS"
+	// EnvFieldsKey, ortam değişkenlerini okuyan sabitler: "  static const String apiUrl = String.fromEnvironment('API_URL');"
+	EnvFieldsKey = "ENV_FIELDS"
+	// EnvValuesKey, flavor'ın .env satırları: "API_URL=https://dev.api.example.com"
+	EnvValuesKey = "ENV_VALUES"
+)
+
+// DefaultFlavors, flavors dosyasında flavor listesi verilmezse kullanılan flavor'lar
+var DefaultFlavors = []string{"dev", "staging", "prod"}
+
+// flavorName, flavor isimlerinin biçimidir. İsim dosya ismi, enum değeri ve .env içindeki FLAVOR
+// değeri olarak aynen kullanıldığı için _ gibi enum isminde değişecek karakterlere izin verilmez
+var flavorName = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// FlavorsFile, flavors.json dosyasının içeriğidir. Değişkenler template değişkenleri ile aynı
+// biçimde tanımlanır; bir flavor için değeri verilmeyen değişkenler kullanıcıya sorulur.
+type FlavorsFile struct {
+	Flavors   []string                          `json:"flavors"`
+	Variables []template.Variable               `json:"variables"`
+	Values    map[string]map[string]interface{} `json:"values"`
+}
+
+// Flavor, değerleri toplanmış bir flavor'dır
+type Flavor struct {
+	Name   string
+	Values map[string]string
+}
+
+// FlavorsResult, flavor generator'ının sonucunu tutar
+type FlavorsResult struct {
+	Flavors []Flavor
+	// Changed, içeriği değişen (dry-run'da değişecek) dosyalar
+	Changed []string
+	// Removed, flavors dosyasından çıkarılan flavor'ların silinen dosyaları
+	Removed []string
+}
+
+// ReadFlavorsFile, flavors dosyasını okur ve doğrular. Flavor listesi boşsa dev, staging ve prod kullanılır.
+// values içinde tanımlı olmayan değişkenler string olarak eklenir.
+func ReadFlavorsFile(flavorsPath string) (*FlavorsFile, error) {
+	data, err := os.ReadFile(flavorsPath)
+	if err != nil {
+		return nil, fmt.Errorf("flavors dosyası okunamadı: %v", err)
+	}
+
+	var file FlavorsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("flavors JSON parse hatası: %v", err)
+	}
+	if len(file.Flavors) == 0 {
+		file.Flavors = DefaultFlavors
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range file.Flavors {
+		if !flavorName.MatchString(name) {
+			return nil, fmt.Errorf("geçersiz flavor ismi: %q (küçük harf ile başlamalı, sadece küçük harf ve rakam içermeli)", name)
+		}
+		if enum, _ := render.ConvertCase(name, "camel"); dartKeywords[enum] {
+			return nil, fmt.Errorf("flavor ismi Dart anahtar kelimesi olamaz: %s", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%s flavor'ı birden fazla kez tanımlı", name)
+		}
+		seen[name] = true
+	}
+	for name := range file.Values {
+		if !seen[name] {
+			return nil, fmt.Errorf("values içindeki %s flavor'ı flavors listesinde yok", name)
+		}
+	}
+
+	declared := make(map[string]bool)
+	for _, v := range file.Variables {
+		declared[v.Name] = true
+	}
+	var undeclared []string
+	for _, values := range file.Values {
+		for name := range values {
+			if !declared[name] {
+				declared[name] = true
+				undeclared = append(undeclared, name)
+			}
+		}
+	}
+	sort.Strings(undeclared)
+	for _, name := range undeclared {
+		file.Variables = append(file.Variables, template.Variable{Name: name})
+	}
+
+	if file.Variables, err = template.MergeVariables(file.Variables); err != nil {
+		return nil, err
+	}
+	if declared[FlavorKey] {
+		return nil, fmt.Errorf("%s değişkeni flavor ismi için ayrılmıştır", FlavorKey)
+	}
+	return &file, nil
+}
+
+// ResolveFlavors, her flavor için değişken değerlerini toplar. Varsayılan değerlerde ve verilen değerlerde
+// projenin template değişkenleri ve {FLAVOR} kullanılabilir; eksik değerler kullanıcıya sorulur.
+// Kullanıcıya değer sorulduysa ikinci dönüş değeri true olur.
+func (p *Project) ResolveFlavors(file *FlavorsFile, vars map[string]string) ([]Flavor, bool, error) {
+	ctx := render.NewContext(p.Name, p.Types)
+	for key, value := range p.Values {
+		ctx.Set(key, value)
+	}
+	for key, value := range vars {
+		ctx.Set(key, value)
+	}
+
+	var flavors []Flavor
+	prompted := false
+	for _, name := range file.Flavors {
+		flavorCtx := ctx.With(FlavorKey, name)
+
+		provided := make(map[string]string)
+		for key, value := range template.StringValues(file.Values[name]) {
+			provided[key] = render.ReplaceValues(value, flavorCtx)
+		}
+
+		variables := make([]template.Variable, len(file.Variables))
+		missing := false
+		for i, v := range file.Variables {
+			v.Default = render.ReplaceValues(v.Default, flavorCtx)
+			variables[i] = v
+			if _, ok := provided[v.Name]; !ok {
+				missing = true
+			}
+		}
+		if missing {
+			prompted = true
+			fmt.Printf("ℹ️ %s flavor'ı için değerler toplanıyor...\n", name)
+		}
+
+		values, err := template.ResolveVariables(variables, provided)
+		if err != nil {
+			return nil, false, fmt.Errorf("%s: %v", name, err)
+		}
+		flavors = append(flavors, Flavor{Name: name, Values: values})
+	}
+	return flavors, prompted, nil
+}
+
+// save, flavor'ların toplanan değerlerini flavors dosyasına yazar, böylece sorulan değerler tekrar sorulmaz
+func (f *FlavorsFile) save(flavorsPath string, flavors []Flavor) error {
+	f.Values = make(map[string]map[string]interface{})
+	for _, flavor := range flavors {
+		values := make(map[string]interface{})
+		for _, v := range f.Variables {
+			values[v.Name] = flavor.Values[v.Name]
+		}
+		f.Values[flavor.Name] = values
+	}
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(flavorsPath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("flavors dosyası kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+// envFields, değişkenleri tiplerine göre ortamdan okuyan Dart sabitlerini üretir
+func envFields(variables []template.Variable) string {
+	var lines []string
+	for _, v := range variables {
+		name := propertyName(v.Name)
+		switch v.Type {
+		case template.VariableInt:
+			lines = append(lines, fmt.Sprintf("  static const int %s = int.fromEnvironment('%s');", name, v.Name))
+		case template.VariableBool:
+			lines = append(lines, fmt.Sprintf("  static const bool %s = bool.fromEnvironment('%s');", name, v.Name))
+		case template.VariableList:
+			lines = append(lines, fmt.Sprintf("  static final List<String> %s = const String.fromEnvironment('%s').split(',').where((item) => item.isNotEmpty).toList();", name, v.Name))
+		default:
+			lines = append(lines, fmt.Sprintf("  static const String %s = String.fromEnvironment('%s');", name, v.Name))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// envValues, flavor'ın .env satırlarını değişken sırasıyla üretir
+func envValues(flavor Flavor, variables []template.Variable) string {
+	lines := []string{FlavorKey + "=" + flavor.Name}
+	for _, v := range variables {
+		lines = append(lines, v.Name+"="+envQuote(flavor.Values[v.Name]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// envQuote, boşluk, yorum veya tırnak içeren değerleri çift tırnak içine alır
+func envQuote(value string) string {
+	if value == "" || !strings.ContainsAny(value, " \t#\"'\\") {
+		return value
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// GenerateFlavors, flavors dosyasındaki her flavor için giriş noktası ve .env dosyası, tüm flavor'lar için
+// ortam sınıfı üretir. flavorsPath boşsa proje kökündeki flavors.json kullanılır. Üretilen dosyalar
+// WriteGenerated ile her çalıştırmada baştan yazılır, dosyadan çıkarılan flavor'ların dosyaları silinir.
+func GenerateFlavors(flavorsPath string, opts Options) (*FlavorsResult, error) {
+	p, err := OpenProject(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+	if flavorsPath == "" {
+		flavorsPath = filepath.Join(p.Root, FlavorsFileName)
+	}
+	file, err := ReadFlavorsFile(flavorsPath)
+	if err != nil {
+		return nil, err
+	}
+	return p.GenerateFlavors(file, flavorsPath, opts)
+}
+
+// InitFlavors, yeni oluşturulan projeye flavors dosyasını kopyalar ve flavor dosyalarını üretir
+func InitFlavors(projectRoot string, flavorsPath string) (*FlavorsResult, error) {
+	file, err := ReadFlavorsFile(flavorsPath)
+	if err != nil {
+		return nil, err
+	}
+	p, err := OpenProjectAt(projectRoot, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(flavorsPath)
+	if err != nil {
+		return nil, fmt.Errorf("flavors dosyası okunamadı: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(p.Root, FlavorsFileName), data, 0644); err != nil {
+		return nil, fmt.Errorf("flavors dosyası kopyalanamadı: %v", err)
+	}
+	return p.GenerateFlavors(file, filepath.Join(p.Root, FlavorsFileName), Options{})
+}
+
+// GenerateFlavors, değerleri toplanan flavor'lar için template'leri render edip yazar.
+// Kullanıcıya sorulan değerler flavorsPath dosyasına kaydedilir.
+func (p *Project) GenerateFlavors(file *FlavorsFile, flavorsPath string, opts Options) (*FlavorsResult, error) {
+	if err := p.Require(opts.Requires...); err != nil {
+		return nil, err
+	}
+	flavors, prompted, err := p.ResolveFlavors(file, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+	if prompted && !opts.DryRun {
+		if err := file.save(flavorsPath, flavors); err != nil {
+			return nil, err
+		}
+	}
+
+	var items []map[string]string
+	var enum []string
+	for _, flavor := range flavors {
+		items = append(items, map[string]string{NameKey: flavor.Name, FlavorKey: flavor.Name, EnvValuesKey: envValues(flavor, file.Variables)})
+		name, _ := render.ConvertCase(flavor.Name, "camel")
+		enum = append(enum, fmt.Sprintf("  %s,", name))
+	}
+	files, err := p.RenderEach(flavorEntryKind, items, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+	config, err := p.RenderEach(flavorConfigKind, []map[string]string{{
+		NameKey:        "app_env",
+		FlavorsListKey: strings.Join(enum, "\n"),
+		EnvFieldsKey:   envFields(file.Variables),
+	}}, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, config...)
+
+	result := &FlavorsResult{Flavors: flavors}
+	if result.Changed, err = p.WriteGenerated(files, FlavorsMarker, opts); err != nil {
+		return nil, err
+	}
+
+	generated := make(map[string]bool)
+	for _, file := range files {
+		generated[filepath.Join(p.Root, file.Path)] = true
+	}
+	if result.Removed, err = p.removeStale(generated, FlavorsMarker, opts.DryRun, "lib/main_*.dart", ".env.*"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// removeStale, desenlere uyan ve marker içeren ama artık üretilmeyen dosyaları siler, silinenleri döndürür
+func (p *Project) removeStale(generated map[string]bool, marker string, dryRun bool, patterns ...string) ([]string, error) {
+	var removed []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(p.Root, filepath.FromSlash(pattern)))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			if generated[match] {
+				continue
+			}
+			data, err := os.ReadFile(match)
+			if err != nil || !strings.Contains(string(data), marker) {
+				continue
+			}
+			if !dryRun {
+				if err := os.Remove(match); err != nil {
+					return nil, fmt.Errorf("dosya silinemedi: %v", err)
+				}
+			}
+			relPath, _ := filepath.Rel(p.Root, match)
+			removed = append(removed, filepath.ToSlash(relPath))
+		}
+	}
+	return removed, nil
+}
@@ -0,0 +1,455 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/project"
+	"github.com/burak/flutter_assist/internal/pubspec"
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// DirName, generator template'lerinin tutulduğu template_util alt klasörüdür.
+// Her generator kendi isminde bir klasör kullanır, örn: generators/feature
+const DirName = "generators"
+
+// NameKey, generator template'lerinde üretilen parçanın ismi için kullanılan anahtar kelimedir.
+// {NAME:snake}, {NAME:pascal} gibi tüm case dönüşümleri ile kullanılabilir
+const NameKey = "NAME"
+
+// FeatureKey, üretilen dosyaların yazılacağı feature'ın ismidir. Verilmezse NAME ile aynıdır
+const FeatureKey = "FEATURE"
+
+// namePattern, generator'a verilen isimlerin biçimidir (user_profile, userProfile, UserProfile)
+var namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// Options, generator çalıştırılırken kullanılacak seçenekleri tutar
+type Options struct {
+	// Types, mimariyi belirleyen type'lar. Boşsa projenin kayıtlı type'ları kullanılır
+	Types []string
+	// Vars, --var ile verilen değişken değerleri
+	Vars map[string]string
+	// Force, mevcut dosyaların üzerine yazılmasına izin verir
+	Force bool
+	// DryRun, dosyaları yazmadan sadece listeler
+	DryRun bool
+	// Requires, üretilen kodun ihtiyaç duyduğu ve pubspec.yaml'da bulunması gereken paketler
+	Requires []string
+	// NoTests, template'lerin companion testlerini üretmez
+	NoTests bool
+}
+
+// Project, generator'ın çalıştığı Flutter projesinin bilgilerini tutar
+type Project struct {
+	Root   string
+	Name   string
+	Types  []string
+	Values map[string]string
+}
+
+// OpenProject, çalışma dizininden yukarı doğru Flutter projesini bulur ve kayıtlı ayarlarını okur.
+// types verilirse projenin kayıtlı type'larının yerine kullanılır.
+func OpenProject(types []string) (*Project, error) {
+	return OpenProjectAt(".", types)
+}
+
+// OpenProjectAt, verilen klasörden yukarı doğru Flutter projesini bulur ve kayıtlı ayarlarını okur
+func OpenProjectAt(dir string, types []string) (*Project, error) {
+	root, err := pubspec.FindRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	name, err := pubspec.Name(filepath.Join(root, pubspec.FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := project.LoadConfig(root)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Project{Root: root, Name: name, Values: map[string]string{}}
+	if config != nil {
+		p.Types = config.Types
+		for key, value := range config.Values {
+			p.Values[key] = value
+		}
+	}
+	if len(types) > 0 {
+		p.Types = types
+	} else if config == nil {
+		fmt.Printf("⚠️ %s bulunamadı, sadece ALL template'leri kullanılacak (-types ile belirtebilirsiniz)\n", project.ConfigFileName)
+	}
+	return p, nil
+}
+
+// CheckName, generator'a verilen ismin geçerli olup olmadığını kontrol eder
+func CheckName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("geçersiz isim: %q (harf ile başlamalı, sadece harf, rakam ve _ içermeli)", name)
+	}
+	return nil
+}
+
+// Generate, generators/<kind> klasöründeki template'leri projenin type'larına göre seçip
+// verilen isimle render eder ve proje içine yazar. Yazılan (dry-run'da yazılacak) dosyalar döndürülür.
+// Mevcut dosyaların üzerine Force verilmedikçe yazılmaz.
+func Generate(kind string, name string, opts Options) ([]template.RenderedFile, error) {
+	if err := CheckName(name); err != nil {
+		return nil, err
+	}
+
+	p, err := OpenProject(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Require(opts.Requires...); err != nil {
+		return nil, err
+	}
+
+	files, err := p.Render(kind, name, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+	if opts.NoTests {
+		files = withoutTests(files)
+	}
+	if err := p.Write(files, opts); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// Require, verilen paketlerden pubspec.yaml'da bulunmayan varsa hata döndürür
+func (p *Project) Require(packages ...string) error {
+	var missing []string
+	for _, pkg := range packages {
+		ok, err := pubspec.HasDependency(filepath.Join(p.Root, pubspec.FileName), pkg)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			missing = append(missing, pkg)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("projede gerekli paketler yok: %s (flutter pub add %s)", strings.Join(missing, ", "), strings.Join(missing, " "))
+	}
+	return nil
+}
+
+// Render, generator template'lerini proje için verilen isimle render eder. Dosya yolları proje köküne göredir
+func (p *Project) Render(kind string, name string, vars map[string]string) ([]template.RenderedFile, error) {
+	return p.RenderEach(kind, []map[string]string{{NameKey: name}}, vars)
+}
+
+// RenderEach, generator template'lerini her eleman için elemanın değerleri ile ayrı ayrı render eder.
+// Her eleman en az NAME değerini içermelidir; değişkenler bir kez toplanır.
+func (p *Project) RenderEach(kind string, items []map[string]string, vars map[string]string) ([]template.RenderedFile, error) {
+	templates, err := loadTemplates(kind, p.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := [][]template.Variable{}
+	for _, tpl := range templates {
+		groups = append(groups, tpl.Variables)
+	}
+	variables, err := template.MergeVariables(groups...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Projenin kayıtlı değerleri önce, --var ile verilenler sonra uygulanır
+	provided := make(map[string]string)
+	for key, value := range p.Values {
+		provided[key] = value
+	}
+	for key, value := range vars {
+		provided[key] = value
+	}
+	values, err := template.ResolveVariables(variables, provided)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := pubspec.Packages(filepath.Join(p.Root, pubspec.FileName))
+	if err != nil {
+		return nil, err
+	}
+	ctx := render.NewContext(p.Name, p.Types)
+	ctx.Packages = packages
+	ctx.LoadPartial = template.GetPartial
+	for key, value := range values {
+		ctx.Set(key, value)
+	}
+
+	var files []template.RenderedFile
+	for _, item := range items {
+		itemCtx := ctx
+		for key, value := range item {
+			itemCtx = itemCtx.With(key, value)
+		}
+		if _, ok := itemCtx.Lookup(FeatureKey); !ok {
+			itemCtx = itemCtx.With(FeatureKey, item[NameKey])
+		}
+
+		for _, tpl := range templates {
+			rendered, err := template.RenderFiles(tpl, itemCtx)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", tpl.Path, err)
+			}
+			files = append(files, rendered...)
+		}
+	}
+	return files, nil
+}
+
+// Write, render edilen dosyaları proje içine yazar.
+// Force verilmedikçe mevcut dosyalar varsa hiçbir dosya yazılmadan hata döner.
+func (p *Project) Write(files []template.RenderedFile, opts Options) error {
+	if !opts.Force {
+		var existing []string
+		for _, file := range files {
+			if _, err := os.Stat(filepath.Join(p.Root, file.Path)); err == nil {
+				existing = append(existing, filepath.ToSlash(file.Path))
+			}
+		}
+		if len(existing) > 0 {
+			return fmt.Errorf("dosyalar zaten mevcut (üzerine yazmak için -force kullanın):\n  %s", strings.Join(existing, "\n  "))
+		}
+	}
+	if opts.DryRun {
+		return nil
+	}
+
+	for _, file := range files {
+		target := filepath.Join(p.Root, file.Path)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("klasör oluşturulamadı: %v", err)
+		}
+		if err := os.WriteFile(target, []byte(file.Content), 0644); err != nil {
+			return fmt.Errorf("dosya oluşturulamadı: %v", err)
+		}
+	}
+	return nil
+}
+
+// WriteGenerated, her çalıştırmada baştan üretilen dosyaları yazar ve içeriği değişen dosyaları döndürür.
+// İçeriği aynı olan dosyalara dokunulmaz; marker içermeyen mevcut dosyalar elle yazılmış kabul edilir
+// ve üzerlerine sadece Force ile yazılır.
+func (p *Project) WriteGenerated(files []template.RenderedFile, marker string, opts Options) ([]string, error) {
+	var changed []template.RenderedFile
+	var paths []string
+	for _, file := range files {
+		existing, err := os.ReadFile(filepath.Join(p.Root, file.Path))
+		switch {
+		case os.IsNotExist(err):
+		case err != nil:
+			return nil, fmt.Errorf("dosya okunamadı: %v", err)
+		case string(existing) == file.Content:
+			continue
+		case !strings.Contains(string(existing), marker) && !opts.Force:
+			return nil, fmt.Errorf("%s flutter_assist tarafından üretilmemiş, üzerine yazmak için -force kullanın", filepath.ToSlash(file.Path))
+		}
+		changed = append(changed, file)
+		paths = append(paths, filepath.ToSlash(file.Path))
+	}
+
+	if err := p.Write(changed, Options{Force: true, DryRun: opts.DryRun}); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// Bölüm işaretleri: "// flutter_assist:begin imports" ve "// flutter_assist:end imports"
+// arasındaki satırlar generator'a aittir, dışında kalan satırlar kullanıcıya aittir
+const (
+	RegionBegin = "flutter_assist:begin"
+	RegionEnd   = "flutter_assist:end"
+)
+
+// regionMarker, bölüm başlangıç veya bitiş satırı
+var regionMarker = regexp.MustCompile(`^\s*//\s*(flutter_assist:(?:begin|end))\s+(\w+)\s*$`)
+
+// WriteRegions, mevcut dosyalarda sadece işaretli bölümleri render edilen dosyadaki karşılıkları ile
+// değiştirir, bölümlerin dışındaki satırlara dokunmaz. Dosya yoksa render edilen içerik yazılır.
+// İşaretli bölümleri bulunmayan mevcut dosyaların üzerine sadece Force ile yazılır. Değişen dosyalar döndürülür.
+func (p *Project) WriteRegions(files []template.RenderedFile, opts Options) ([]string, error) {
+	var changed []template.RenderedFile
+	var paths []string
+	for _, file := range files {
+		existing, err := os.ReadFile(filepath.Join(p.Root, file.Path))
+		switch {
+		case os.IsNotExist(err):
+		case err != nil:
+			return nil, fmt.Errorf("dosya okunamadı: %v", err)
+		default:
+			merged, err := mergeRegions(string(existing), file.Content)
+			if err != nil && !opts.Force {
+				return nil, fmt.Errorf("%s: %v (üzerine yazmak için -force kullanın)", filepath.ToSlash(file.Path), err)
+			}
+			if err == nil {
+				file.Content = merged
+			}
+			if file.Content == string(existing) {
+				continue
+			}
+		}
+		changed = append(changed, file)
+		paths = append(paths, filepath.ToSlash(file.Path))
+	}
+
+	if err := p.Write(changed, Options{Force: true, DryRun: opts.DryRun}); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// mergeRegions, existing içindeki işaretli bölümlerin içeriğini generated içindeki aynı isimli bölümlerle değiştirir
+func mergeRegions(existing string, generated string) (string, error) {
+	regions, err := parseRegions(generated)
+	if err != nil {
+		return "", err
+	}
+	if len(regions) == 0 {
+		return "", fmt.Errorf("template'te %s bölümü yok", RegionBegin)
+	}
+
+	lines := strings.Split(existing, "\n")
+	var result []string
+	seen := make(map[string]bool)
+	current := ""
+	for _, line := range lines {
+		m := regionMarker.FindStringSubmatch(line)
+		switch {
+		case m != nil && m[1] == RegionBegin:
+			if current != "" {
+				return "", fmt.Errorf("%s bölümü kapatılmadan %s bölümü açılmış", current, m[2])
+			}
+			current = m[2]
+			result = append(result, line)
+			if content, ok := regions[m[2]]; ok {
+				seen[m[2]] = true
+				result = append(result, content...)
+			}
+		case m != nil && m[1] == RegionEnd:
+			if current != m[2] {
+				return "", fmt.Errorf("%s bölümünün sonu beklenmiyordu", m[2])
+			}
+			current = ""
+			result = append(result, line)
+		case current != "" && seen[current]:
+			// Bölümün eski içeriği atlanır
+		default:
+			result = append(result, line)
+		}
+	}
+	if current != "" {
+		return "", fmt.Errorf("%s bölümü kapatılmamış", current)
+	}
+	for name := range regions {
+		if !seen[name] {
+			return "", fmt.Errorf("%s %s bölümü bulunamadı", RegionBegin, name)
+		}
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+// parseRegions, içerikteki işaretli bölümlerin satırlarını döndürür
+func parseRegions(content string) (map[string][]string, error) {
+	regions := make(map[string][]string)
+	current := ""
+	for _, line := range strings.Split(content, "\n") {
+		m := regionMarker.FindStringSubmatch(line)
+		switch {
+		case m != nil && m[1] == RegionBegin:
+			current = m[2]
+			regions[current] = []string{}
+		case m != nil && m[1] == RegionEnd:
+			current = ""
+		case current != "":
+			regions[current] = append(regions[current], line)
+		}
+	}
+	if current != "" {
+		return nil, fmt.Errorf("%s bölümü kapatılmamış", current)
+	}
+	return regions, nil
+}
+
+// withoutTests, companion test dosyalarını listeden çıkarır
+func withoutTests(files []template.RenderedFile) []template.RenderedFile {
+	var kept []template.RenderedFile
+	for _, file := range files {
+		if !file.Test {
+			kept = append(kept, file)
+		}
+	}
+	return kept
+}
+
+// loadTemplates, generators/<kind> klasöründeki template'lerden type'lara uyanları döndürür
+func loadTemplates(kind string, types []string) ([]*template.Template, error) {
+	dir, err := Dir(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s generator'ı için template bulunamadı: %s", kind, dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("generator klasörü okunamadı: %v", err)
+	}
+
+	var templates []*template.Template
+	for _, entry := range entries {
+		if entry.IsDir() || !template.IsTemplateFile(entry.Name()) {
+			continue
+		}
+
+		tpl, err := template.LoadTemplate(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", entry.Name(), err)
+		}
+		if matchesTypes(tpl.Types, types) {
+			templates = append(templates, tpl)
+		}
+	}
+
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("%s generator'ında seçili type'lara uyan template yok", kind)
+	}
+	return templates, nil
+}
+
+// Dir, generator template'lerinin klasörünü döndürür
+func Dir(kind string) (string, error) {
+	execPath, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
+	}
+	return filepath.Join(filepath.Dir(execPath), "template_util", DirName, kind), nil
+}
+
+// matchesTypes, template'in type'larından biri ALL ise veya seçili type'larda varsa true döner
+func matchesTypes(templateTypes []string, types []string) bool {
+	for _, templateType := range templateTypes {
+		if templateType == "ALL" {
+			return true
+		}
+		for _, t := range types {
+			if t == templateType {
+				return true
+			}
+		}
+	}
+	return false
+}
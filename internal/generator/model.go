@@ -0,0 +1,534 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// ModelKind, model generator'ının template_util/generators altındaki klasörüdür.
+// Her serileştirme stili ayrı bir alt klasördür, örn: generators/model/manual
+const ModelKind = "model"
+
+// Model stilleri
+const (
+	// ModelStyleManual, fromJson/toJson metodlarının elle yazıldığı stil
+	ModelStyleManual = "manual"
+	// ModelStyleJSONSerializable, json_serializable ile kod üretilen stil
+	ModelStyleJSONSerializable = "json_serializable"
+)
+
+// Model template'lerine her sınıf için verilen değerler
+const (
+	// FieldsKey, alan tanımları: "  final String name;"
+	FieldsKey = "FIELDS"
+	// JSONFieldsKey, JSON anahtarı alan isminden farklıysa @JsonKey ile işaretlenmiş alan tanımları
+	JSONFieldsKey = "JSON_FIELDS"
+	// ParamsKey, constructor parametreleri: "    required this.name,"
+	ParamsKey = "PARAMS"
+	// FromJSONKey, fromJson içindeki atamalar: "      name: json['name'] as String,"
+	FromJSONKey = "FROM_JSON"
+	// ToJSONKey, toJson içindeki girdiler: "      'name': name,"
+	ToJSONKey = "TO_JSON"
+	// ImportsKey, sınıfın kullandığı diğer model dosyalarının importları
+	ImportsKey = "IMPORTS"
+)
+
+// Çıkarılan JSON değer türleri
+const (
+	kindNull    = "null"
+	kindBool    = "bool"
+	kindInt     = "int"
+	kindDouble  = "double"
+	kindString  = "String"
+	kindDynamic = "dynamic"
+	// kindEmpty, boş listelerin eleman türüdür; başka bir türle birleşince o türü alır
+	kindEmpty  = "empty"
+	kindObject = "object"
+	kindList   = "list"
+)
+
+// jsonType, örnek JSON'dan çıkarılan bir değerin türünü tutar
+type jsonType struct {
+	Kind     string
+	Nullable bool
+	// Object, nesneler için alanlar
+	Object *jsonObject
+	// Elem, listeler için eleman türü
+	Elem *jsonType
+	// Class, nesneye verilen Dart sınıf ismi
+	Class string
+}
+
+// jsonObject, bir nesnenin alanlarını ilk görüldükleri sırada tutar
+type jsonObject struct {
+	Keys   []string
+	Fields map[string]*jsonType
+}
+
+// Model, örnek JSON'dan çıkarılan bir Dart sınıfıdır
+type Model struct {
+	Name   string
+	Fields []ModelField
+}
+
+// ModelField, model sınıfının bir alanıdır
+type ModelField struct {
+	// Key, JSON'daki anahtar
+	Key string
+	// Property, Dart'taki alan ismi
+	Property string
+	Type     *jsonType
+}
+
+// dartKeywords, alan ismi olarak kullanılamayan Dart anahtar kelimeleri
+var dartKeywords = map[string]bool{
+	"assert": true, "break": true, "case": true, "catch": true, "class": true, "const": true,
+	"continue": true, "default": true, "do": true, "else": true, "enum": true, "extends": true,
+	"false": true, "final": true, "finally": true, "for": true, "if": true, "in": true,
+	"is": true, "new": true, "null": true, "rethrow": true, "return": true, "super": true,
+	"switch": true, "this": true, "throw": true, "true": true, "try": true, "var": true,
+	"void": true, "while": true, "with": true,
+}
+
+// blankLines, üç veya daha fazla ardışık satır sonu
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// InferModels, örnek JSON'dan kök sınıf ve iç içe nesneler için model sınıflarını çıkarır.
+// Kök bir nesne veya nesne listesi olmalıdır. Null değerli veya listedeki bazı elemanlarda
+// bulunmayan alanlar nullable olur.
+func InferModels(name string, sample []byte) ([]Model, error) {
+	decoder := json.NewDecoder(bytes.NewReader(sample))
+	decoder.UseNumber()
+	root, err := inferValue(decoder)
+	if err != nil {
+		return nil, fmt.Errorf("örnek JSON parse hatası: %v", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("örnek JSON parse hatası: tek bir değer olmalı")
+	}
+
+	if root.Kind == kindList && root.Elem != nil {
+		root = root.Elem
+	}
+	if root.Kind != kindObject {
+		return nil, fmt.Errorf("örnek JSON bir nesne veya nesne listesi olmalı")
+	}
+
+	className, _ := render.ConvertCase(name, "pascal")
+	var models []Model
+	used := make(map[string]bool)
+	collectModels(root, className, "", used, &models)
+	return models, nil
+}
+
+// inferValue, decoder'daki sıradaki değerin türünü çıkarır
+func inferValue(decoder *json.Decoder) (*jsonType, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := token.(type) {
+	case nil:
+		return &jsonType{Kind: kindNull, Nullable: true}, nil
+	case bool:
+		return &jsonType{Kind: kindBool}, nil
+	case string:
+		return &jsonType{Kind: kindString}, nil
+	case json.Number:
+		if strings.ContainsAny(value.String(), ".eE") {
+			return &jsonType{Kind: kindDouble}, nil
+		}
+		return &jsonType{Kind: kindInt}, nil
+	case json.Delim:
+		if value == '{' {
+			object := &jsonObject{Fields: make(map[string]*jsonType)}
+			for decoder.More() {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				key := keyToken.(string)
+				field, err := inferValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				if existing, ok := object.Fields[key]; ok {
+					object.Fields[key] = mergeTypes(existing, field)
+					continue
+				}
+				object.Keys = append(object.Keys, key)
+				object.Fields[key] = field
+			}
+			if _, err := decoder.Token(); err != nil {
+				return nil, err
+			}
+			return &jsonType{Kind: kindObject, Object: object}, nil
+		}
+
+		// Liste elemanlarının türleri tek bir türde birleştirilir
+		var elem *jsonType
+		for decoder.More() {
+			item, err := inferValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			if elem == nil {
+				elem = item
+			} else {
+				elem = mergeTypes(elem, item)
+			}
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		if elem == nil {
+			elem = &jsonType{Kind: kindEmpty}
+		}
+		return &jsonType{Kind: kindList, Elem: elem}, nil
+	}
+	return nil, fmt.Errorf("beklenmeyen JSON değeri: %v", token)
+}
+
+// mergeTypes, aynı alana ait iki türü birleştirir.
+// null ile birleşen tür nullable olur, int ve double double olur, uyuşmayan türler dynamic olur.
+func mergeTypes(a, b *jsonType) *jsonType {
+	switch {
+	case a.Kind == kindEmpty:
+		return b
+	case b.Kind == kindEmpty:
+		return a
+	case a.Kind == kindNull:
+		merged := *b
+		merged.Nullable = true
+		return &merged
+	case b.Kind == kindNull:
+		merged := *a
+		merged.Nullable = true
+		return &merged
+	}
+
+	nullable := a.Nullable || b.Nullable
+	switch {
+	case a.Kind == b.Kind && a.Kind == kindObject:
+		object := &jsonObject{Fields: make(map[string]*jsonType)}
+		for _, key := range a.Object.Keys {
+			object.Keys = append(object.Keys, key)
+			if other, ok := b.Object.Fields[key]; ok {
+				object.Fields[key] = mergeTypes(a.Object.Fields[key], other)
+			} else {
+				object.Fields[key] = nullableCopy(a.Object.Fields[key])
+			}
+		}
+		for _, key := range b.Object.Keys {
+			if _, ok := a.Object.Fields[key]; !ok {
+				object.Keys = append(object.Keys, key)
+				object.Fields[key] = nullableCopy(b.Object.Fields[key])
+			}
+		}
+		return &jsonType{Kind: kindObject, Object: object, Nullable: nullable}
+	case a.Kind == b.Kind && a.Kind == kindList:
+		return &jsonType{Kind: kindList, Elem: mergeTypes(a.Elem, b.Elem), Nullable: nullable}
+	case a.Kind == kindDynamic && b.Kind == kindDynamic:
+		return &jsonType{Kind: kindDynamic}
+	case a.Kind == b.Kind:
+		return &jsonType{Kind: a.Kind, Nullable: nullable}
+	case (a.Kind == kindInt || a.Kind == kindDouble) && (b.Kind == kindInt || b.Kind == kindDouble):
+		return &jsonType{Kind: kindDouble, Nullable: nullable}
+	default:
+		return &jsonType{Kind: kindDynamic}
+	}
+}
+
+// nullableCopy, türün nullable bir kopyasını döndürür
+func nullableCopy(t *jsonType) *jsonType {
+	c := *t
+	c.Nullable = true
+	return &c
+}
+
+// collectModels, nesne türüne sınıf ismi verir ve iç içe nesneleri de modellere ekler.
+// Aynı isim daha önce kullanıldıysa isim üst sınıfın ismi ile öneklenir.
+func collectModels(t *jsonType, className string, parent string, used map[string]bool, models *[]Model) {
+	if used[className] {
+		className = parent + className
+	}
+	for base, i := className, 2; used[className]; i++ {
+		className = fmt.Sprintf("%s%d", base, i)
+	}
+	used[className] = true
+	t.Class = className
+
+	model := Model{Name: className}
+	index := len(*models)
+	*models = append(*models, model)
+
+	// Aynı alan ismine çevrilen anahtarlara sıra numarası eklenir: user_name, userName -> userName, userName2
+	properties := make(map[string]bool)
+
+	for _, key := range t.Object.Keys {
+		field := t.Object.Fields[key]
+
+		// Liste içindeki nesneler için sınıf ismi tekil yapılır: addresses -> Address
+		nested, nestedName := field, key
+		for nested.Kind == kindList {
+			nested, nestedName = nested.Elem, singular(nestedName)
+		}
+		if nested.Kind == kindObject {
+			name, _ := render.ConvertCase(nestedName, "pascal")
+			collectModels(nested, name, className, used, models)
+		}
+
+		property := propertyName(key)
+		for base, i := property, 2; properties[property]; i++ {
+			property = fmt.Sprintf("%s%d", base, i)
+		}
+		properties[property] = true
+		model.Fields = append(model.Fields, ModelField{Key: key, Property: property, Type: field})
+	}
+	(*models)[index] = model
+}
+
+// singular, liste alanının isminden eleman sınıfı için tekil bir isim çıkarır
+func singular(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, "ies") && len(name) > 3:
+		return name[:len(name)-3] + "y"
+	case strings.HasSuffix(lower, "sses"), strings.HasSuffix(lower, "xes"),
+		strings.HasSuffix(lower, "ches"), strings.HasSuffix(lower, "shes"):
+		// addresses -> address, boxes -> box, matches -> match
+		return name[:len(name)-2]
+	case strings.HasSuffix(lower, "ss"), strings.HasSuffix(lower, "us"), strings.HasSuffix(lower, "is"):
+		return name
+	case strings.HasSuffix(lower, "s") && len(name) > 1:
+		return name[:len(name)-1]
+	default:
+		return name + "Item"
+	}
+}
+
+// propertyName, JSON anahtarından geçerli bir Dart alan ismi üretir
+func propertyName(key string) string {
+	name, _ := render.ConvertCase(key, "camel")
+	if name == "" {
+		name = "value"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "value" + name
+	}
+	if dartKeywords[name] {
+		name += "Value"
+	}
+	return name
+}
+
+// dartType, türün Dart karşılığını döndürür
+func dartType(t *jsonType) string {
+	var name string
+	switch t.Kind {
+	case kindObject:
+		name = t.Class
+	case kindList:
+		name = "List<" + dartType(t.Elem) + ">"
+	case kindNull, kindDynamic, kindEmpty:
+		return "dynamic"
+	default:
+		name = t.Kind
+	}
+	if t.Nullable {
+		name += "?"
+	}
+	return name
+}
+
+// fromJSONExpr, JSON değerini Dart türüne çeviren ifadeyi döndürür
+func fromJSONExpr(t *jsonType, expr string) string {
+	optional := ""
+	if t.Nullable {
+		optional = "?"
+	}
+
+	switch t.Kind {
+	case kindNull, kindDynamic, kindEmpty:
+		return expr
+	case kindDouble:
+		return fmt.Sprintf("(%s as num%s)%s.toDouble()", expr, optional, optional)
+	case kindObject:
+		parse := fmt.Sprintf("%s.fromJson(%s as Map<String, dynamic>)", t.Class, expr)
+		if t.Nullable {
+			return fmt.Sprintf("%s == null ? null : %s", expr, parse)
+		}
+		return parse
+	case kindList:
+		return fmt.Sprintf("(%s as List<dynamic>%s)%s.map((e) => %s).toList()", expr, optional, optional, fromJSONExpr(t.Elem, "e"))
+	default:
+		return fmt.Sprintf("%s as %s", expr, dartType(t))
+	}
+}
+
+// toJSONExpr, Dart değerini JSON'a çeviren ifadeyi döndürür
+func toJSONExpr(t *jsonType, expr string) string {
+	optional := ""
+	if t.Nullable {
+		optional = "?"
+	}
+
+	switch {
+	case t.Kind == kindObject:
+		return fmt.Sprintf("%s%s.toJson()", expr, optional)
+	case t.Kind == kindList && hasObject(t.Elem):
+		return fmt.Sprintf("%s%s.map((e) => %s).toList()", expr, optional, toJSONExpr(t.Elem, "e"))
+	default:
+		return expr
+	}
+}
+
+// hasObject, türün kendisi veya liste elemanları nesne ise true döner
+func hasObject(t *jsonType) bool {
+	for t.Kind == kindList {
+		t = t.Elem
+	}
+	return t.Kind == kindObject
+}
+
+// referencedClasses, türün kullandığı model sınıflarını döndürür
+func referencedClasses(t *jsonType) []string {
+	for t.Kind == kindList {
+		t = t.Elem
+	}
+	if t.Kind == kindObject {
+		return []string{t.Class}
+	}
+	return nil
+}
+
+// dartStringEscaper, tek tırnaklı Dart string'inde özel anlamı olan karakterleri kaçırır
+var dartStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`, `$`, `\$`)
+
+// dartString, JSON anahtarını tek tırnaklı bir Dart string'i içine yazılabilir hale getirir: $id -> \$id
+func dartString(value string) string {
+	return dartStringEscaper.Replace(value)
+}
+
+// Values, model template'lerinde kullanılan değerleri döndürür
+func (m Model) Values() map[string]string {
+	var fields, jsonFields, params, fromJSON, toJSON []string
+	imports := make(map[string]bool)
+
+	for _, field := range m.Fields {
+		declaration := fmt.Sprintf("  final %s %s;", dartType(field.Type), field.Property)
+		fields = append(fields, declaration)
+		if field.Key != field.Property {
+			jsonFields = append(jsonFields, fmt.Sprintf("  @JsonKey(name: '%s')", dartString(field.Key)))
+		}
+		jsonFields = append(jsonFields, declaration)
+
+		if field.Type.Nullable || dartType(field.Type) == "dynamic" {
+			params = append(params, fmt.Sprintf("    this.%s,", field.Property))
+		} else {
+			params = append(params, fmt.Sprintf("    required this.%s,", field.Property))
+		}
+
+		fromJSON = append(fromJSON, fmt.Sprintf("      %s: %s,", field.Property, fromJSONExpr(field.Type, fmt.Sprintf("json['%s']", dartString(field.Key)))))
+		toJSON = append(toJSON, fmt.Sprintf("      '%s': %s,", dartString(field.Key), toJSONExpr(field.Type, field.Property)))
+
+		for _, class := range referencedClasses(field.Type) {
+			if class != m.Name {
+				snake, _ := render.ConvertCase(class, "snake")
+				imports[fmt.Sprintf("import '%s.dart';", snake)] = true
+			}
+		}
+	}
+
+	var importLines []string
+	for line := range imports {
+		importLines = append(importLines, line)
+	}
+	sort.Strings(importLines)
+
+	return map[string]string{
+		NameKey:       m.Name,
+		FieldsKey:     strings.Join(fields, "\n"),
+		JSONFieldsKey: strings.Join(jsonFields, "\n"),
+		ParamsKey:     strings.Join(params, "\n"),
+		FromJSONKey:   strings.Join(fromJSON, "\n"),
+		ToJSONKey:     strings.Join(toJSON, "\n"),
+		ImportsKey:    strings.Join(importLines, "\n"),
+	}
+}
+
+// modelRequires, model stillerinin pubspec.yaml'da bulunması gereken paketleri
+var modelRequires = map[string][]string{
+	ModelStyleJSONSerializable: {"json_annotation"},
+}
+
+// GenerateModels, örnek JSON dosyasından model sınıflarını çıkarır ve generators/model/<stil>
+// template'leri ile her sınıf için ayrı dosya üretir. Feature verilmezse kök sınıfın ismi kullanılır.
+func GenerateModels(name string, samplePath string, style string, opts Options) ([]template.RenderedFile, error) {
+	if err := CheckName(name); err != nil {
+		return nil, err
+	}
+	kind := ModelKind + "/" + style
+	dir, err := Dir(kind)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("bilinmeyen model stili: %s (%s klasörü yok)", style, dir)
+	}
+
+	sample, err := os.ReadFile(samplePath)
+	if err != nil {
+		return nil, fmt.Errorf("örnek JSON okunamadı: %v", err)
+	}
+	models, err := InferModels(name, sample)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := OpenProject(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Require(append(opts.Requires, modelRequires[style]...)...); err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string)
+	for key, value := range opts.Vars {
+		vars[key] = value
+	}
+	if _, ok := vars[FeatureKey]; !ok {
+		vars[FeatureKey] = name
+	}
+
+	var items []map[string]string
+	for _, model := range models {
+		items = append(items, model.Values())
+	}
+	files, err := p.RenderEach(kind, items, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	// Boş import listesi gibi değerlerin bıraktığı fazla boş satırlar temizlenir
+	for i := range files {
+		content := strings.TrimLeft(files[i].Content, "\n")
+		files[i].Content = blankLines.ReplaceAllString(content, "\n\n")
+	}
+
+	if err := p.Write(files, opts); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
@@ -0,0 +1,92 @@
+package generator_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/burak/flutter_assist/internal/generator"
+)
+
+func TestInferModels(t *testing.T) {
+	tests := []struct {
+		name   string
+		sample string
+		// models, üretilmesi beklenen sınıflar
+		models []string
+		// properties, kök sınıfın alan isimleri
+		properties []string
+		// contains, kök sınıfın template değerlerinde bulunması gereken satırlar
+		contains []string
+	}{
+		{
+			name:       "alan isimleri",
+			sample:     `{"user_name": "a", "class": "b", "2fa": true}`,
+			models:     []string{"User"},
+			properties: []string{"userName", "classValue", "value2fa"},
+			contains:   []string{"@JsonKey(name: 'user_name')", "userName: json['user_name'] as String,"},
+		},
+		{
+			name:       "çakışan alan isimleri",
+			sample:     `{"user_name": "a", "userName": "b"}`,
+			models:     []string{"User"},
+			properties: []string{"userName", "userName2"},
+			contains:   []string{"userName2: json['userName'] as String,"},
+		},
+		{
+			name:       "özel karakterli anahtarlar",
+			sample:     `{"$id": "1", "it's": 1, "a\\b": true}`,
+			models:     []string{"User"},
+			properties: []string{"id", "itS", "aB"},
+			contains: []string{
+				`@JsonKey(name: '\$id')`,
+				`id: json['\$id'] as String,`,
+				`'\$id': id,`,
+				`itS: json['it\'s'] as int,`,
+				`aB: json['a\\b'] as bool,`,
+			},
+		},
+		{
+			name:       "iç içe nesne ve tekil isimler",
+			sample:     `{"addresses": [{"city": "x"}], "boxes": [{"id": 1}], "matches": [{"id": 2}], "profile": {"age": null}}`,
+			models:     []string{"User", "Address", "Box", "Match", "Profile"},
+			properties: []string{"addresses", "boxes", "matches", "profile"},
+			contains:   []string{"final List<Address> addresses;", "final Profile profile;"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			models, err := generator.InferModels("user", []byte(tt.sample))
+			if err != nil {
+				t.Fatalf("InferModels: %v", err)
+			}
+
+			var names []string
+			for _, m := range models {
+				names = append(names, m.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.models, ",") {
+				t.Fatalf("sınıflar %v, beklenen %v", names, tt.models)
+			}
+
+			var properties []string
+			for _, field := range models[0].Fields {
+				properties = append(properties, field.Property)
+			}
+			if strings.Join(properties, ",") != strings.Join(tt.properties, ",") {
+				t.Fatalf("alanlar %v, beklenen %v", properties, tt.properties)
+			}
+
+			var values []string
+			for _, value := range models[0].Values() {
+				values = append(values, value)
+			}
+			all := strings.Join(values, "\n")
+			for _, line := range tt.contains {
+				if !strings.Contains(all, line) {
+					t.Errorf("%q bulunamadı:\n%s", line, all)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package generator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/project"
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// RoutesKind, route generator'ının template_util/generators altındaki klasörüdür.
+// Her router ayrı bir alt klasördür, örn: generators/routes/go_router
+const RoutesKind = "routes"
+
+// Desteklenen router'lar
+const (
+	RouterGoRouter  = "go_router"
+	RouterAutoRoute = "auto_route"
+)
+
+// RoutesMarker, route generator'ının her çalıştırmada yeniden yazdığı dosyaları işaretler.
+// Bu işareti içermeyen mevcut dosyaların üzerine yazılmaz, taramada da atlanırlar
+const RoutesMarker = "flutter_assist gen routes"
+
+// Route template'lerine verilen değerler
+const (
+	// RoutesKey, router tablosundaki route girdileri
+	RoutesKey = "ROUTES"
+	// PathsKey, route yolları için sabitler: "  static const userProfile = '/user-profile';"
+	PathsKey = "PATHS"
+	// InitialKey, açılışta gösterilecek route'un yolu
+	InitialKey = "INITIAL"
+)
+
+// routePackages, router'ların pubspec.yaml'da bulunması gereken paketleri
+var routePackages = map[string][]string{
+	RouterGoRouter:  {"go_router"},
+	RouterAutoRoute: {"auto_route"},
+}
+
+// routeComment, sayfa sınıfını işaretleyen yorum satırı: "// @route" veya "// @route /profil"
+var routeComment = regexp.MustCompile(`^\s*//\s*@route(?:\s+(\S+))?\s*$`)
+
+// classDeclaration, public bir sınıf tanımı
+var classDeclaration = regexp.MustCompile(`^\s*(?:(?:abstract|base|final|sealed|interface)\s+)*class\s+([A-Z]\w*)\b`)
+
+// pageSuffixes, isim kuralı ile bulunan sayfa sınıflarının ve dosyalarının sonekleri
+var pageSuffixes = []string{"View", "Page"}
+
+// Route, lib/ altında bulunan bir sayfa widget'ıdır
+type Route struct {
+	// Class, sayfa widget'ının sınıf ismi
+	Class string
+	// Path, route yolu: "/user-profile"
+	Path string
+	// Name, route ismi: "userProfile"
+	Name string
+	// File, sınıfın tanımlandığı dosyanın lib/ klasörüne göre yolu
+	File string
+	// RoutePage, sınıf @RoutePage() ile işaretlenmişse true olur (auto_route)
+	RoutePage bool
+}
+
+// ScanRoutes, lib/ altındaki dart dosyalarında sayfa widget'larını arar.
+// "// @route" yorumu ile işaretlenen sınıflar ve *_view.dart / *_page.dart dosyalarındaki
+// View veya Page ile biten sınıflar sayfa kabul edilir. Route'lar yola göre sıralanır.
+func ScanRoutes(root string) ([]Route, error) {
+	libDir := filepath.Join(root, "lib")
+	var routes []Route
+	err := filepath.WalkDir(libDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".dart") || strings.HasSuffix(d.Name(), ".g.dart") || strings.HasSuffix(d.Name(), ".gr.dart") {
+			return nil
+		}
+
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(string(data), RoutesMarker) {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(libDir, filePath)
+		if err != nil {
+			return err
+		}
+		routes = append(routes, scanFile(filepath.ToSlash(relPath), string(data))...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lib klasörü okunamadı: %v", err)
+	}
+
+	// Yol ve isim AppRoutes sabiti ile GoRoute ismi olarak kullanıldığı için ikisi de benzersiz olmalı
+	seenPaths := make(map[string]Route)
+	seenNames := make(map[string]Route)
+	for _, route := range routes {
+		if other, ok := seenPaths[route.Path]; ok {
+			return nil, fmt.Errorf("%s yolu iki sayfada kullanılıyor: %s (%s) ve %s (%s)", route.Path, other.Class, other.File, route.Class, route.File)
+		}
+		if other, ok := seenNames[route.Name]; ok {
+			return nil, fmt.Errorf("%s route ismi iki sayfada kullanılıyor: %s (%s) ve %s (%s)", route.Name, other.Class, other.File, route.Class, route.File)
+		}
+		seenPaths[route.Path] = route
+		seenNames[route.Name] = route
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Path < routes[j].Path
+	})
+	return routes, nil
+}
+
+// scanFile, bir dart dosyasındaki sayfa sınıflarını döndürür
+func scanFile(relPath string, content string) []Route {
+	conventional := false
+	for _, suffix := range pageSuffixes {
+		if strings.HasSuffix(relPath, "_"+strings.ToLower(suffix)+".dart") {
+			conventional = true
+		}
+	}
+
+	var routes []Route
+	marked, customPath, routePage := false, "", false
+	for _, line := range strings.Split(content, "\n") {
+		if m := routeComment.FindStringSubmatch(line); m != nil {
+			marked, customPath = true, m[1]
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "@RoutePage") {
+			routePage = true
+			continue
+		}
+
+		m := classDeclaration.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		class := m[1]
+		if marked || conventional && hasPageSuffix(class) {
+			routes = append(routes, newRoute(class, customPath, relPath, routePage))
+		}
+		marked, customPath, routePage = false, "", false
+	}
+	return routes
+}
+
+// hasPageSuffix, sınıf isminin View veya Page ile bitip bitmediğini döndürür
+func hasPageSuffix(class string) bool {
+	for _, suffix := range pageSuffixes {
+		if strings.HasSuffix(class, suffix) && class != suffix {
+			return true
+		}
+	}
+	return false
+}
+
+// newRoute, sınıf isminden route yolunu ve ismini üretir: UserProfileView -> /user-profile, userProfile
+func newRoute(class string, customPath string, relPath string, routePage bool) Route {
+	base := class
+	for _, suffix := range pageSuffixes {
+		if trimmed := strings.TrimSuffix(class, suffix); trimmed != class && trimmed != "" {
+			base = trimmed
+			break
+		}
+	}
+
+	name, _ := render.ConvertCase(base, "camel")
+	path := customPath
+	if path == "" {
+		kebab, _ := render.ConvertCase(base, "kebab")
+		path = "/" + kebab
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return Route{Class: class, Path: path, Name: name, File: relPath, RoutePage: routePage}
+}
+
+// autoRouteName, auto_route'un sayfa sınıfı için ürettiği route ismidir: ProfilePage -> ProfileRoute
+func autoRouteName(class string) string {
+	for _, suffix := range []string{"Page", "Screen"} {
+		if trimmed := strings.TrimSuffix(class, suffix); trimmed != class && trimmed != "" {
+			return trimmed + "Route"
+		}
+	}
+	return class + "Route"
+}
+
+// routeValues, router template'lerinde kullanılan değerleri üretir
+func routeValues(projectName string, router string, routes []Route) map[string]string {
+	var entries, paths, imports []string
+	for _, route := range routes {
+		imports = append(imports, fmt.Sprintf("import 'package:%s/%s';", projectName, route.File))
+		paths = append(paths, fmt.Sprintf("  static const %s = '%s';", route.Name, route.Path))
+
+		if router == RouterAutoRoute {
+			entries = append(entries, fmt.Sprintf("        AutoRoute(page: %s.page, path: AppRoutes.%s),", autoRouteName(route.Class), route.Name))
+			continue
+		}
+		entries = append(entries, strings.Join([]string{
+			"      GoRoute(",
+			fmt.Sprintf("        path: AppRoutes.%s,", route.Name),
+			fmt.Sprintf("        name: '%s',", route.Name),
+			fmt.Sprintf("        builder: (context, state) => const %s(),", route.Class),
+			"      ),",
+		}, "\n"))
+	}
+	sort.Strings(imports)
+	imports = uniqueSorted(imports)
+
+	initial := "/"
+	if len(routes) > 0 {
+		initial = routes[0].Path
+	}
+
+	return map[string]string{
+		NameKey:    "app_router",
+		RoutesKey:  strings.Join(entries, "\n"),
+		PathsKey:   strings.Join(paths, "\n"),
+		ImportsKey: strings.Join(imports, "\n"),
+		InitialKey: initial,
+	}
+}
+
+// uniqueSorted, sıralı listedeki tekrar eden elemanları kaldırır
+func uniqueSorted(items []string) []string {
+	var result []string
+	for i, item := range items {
+		if i == 0 || item != items[i-1] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ProjectRouter, projenin type'larından router'ı seçer. Hiçbir type router belirtmiyorsa go_router döner
+func ProjectRouter(types []string) (string, error) {
+	templateTypes, err := project.GetTemplateTypes()
+	if err != nil {
+		return "", err
+	}
+	for _, t := range templateTypes {
+		if t.Router != "" && matchesTypes([]string{t.Name}, types) {
+			return t.Router, nil
+		}
+	}
+	return RouterGoRouter, nil
+}
+
+// RoutesResult, route generator'ının sonucunu tutar
+type RoutesResult struct {
+	Router string
+	Routes []Route
+	Files  []template.RenderedFile
+	// Changed, içeriği değişen (dry-run'da değişecek) dosyalar
+	Changed []string
+}
+
+// GenerateRoutes, lib/ altındaki sayfaları tarar ve generators/routes/<router> template'leri ile
+// route tablosunu üretir. Router boşsa projenin type'larından seçilir.
+// Üretilen dosyalar WriteGenerated ile her çalıştırmada baştan yazılır.
+func GenerateRoutes(router string, opts Options) (*RoutesResult, error) {
+	p, err := OpenProject(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+	if router == "" {
+		if router, err = ProjectRouter(p.Types); err != nil {
+			return nil, err
+		}
+	}
+
+	kind := RoutesKind + "/" + router
+	dir, err := Dir(kind)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("bilinmeyen router: %s (%s klasörü yok)", router, dir)
+	}
+	if err := p.Require(append(opts.Requires, routePackages[router]...)...); err != nil {
+		return nil, err
+	}
+
+	routes, err := ScanRoutes(p.Root)
+	if err != nil {
+		return nil, err
+	}
+	if len(routes) == 0 {
+		return nil, fmt.Errorf("lib/ altında sayfa bulunamadı (*_view.dart, *_page.dart veya // @route)")
+	}
+
+	files, err := p.RenderEach(kind, []map[string]string{routeValues(p.Name, router, routes)}, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RoutesResult{Router: router, Routes: routes, Files: files}
+	if result.Changed, err = p.WriteGenerated(files, RoutesMarker, opts); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,345 @@
+package locale
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/generator"
+	"github.com/burak/flutter_assist/internal/project"
+	"github.com/burak/flutter_assist/internal/pubspec"
+)
+
+// TranslationsDir, easy_localization çeviri dosyalarının proje içindeki klasörüdür
+const TranslationsDir = "assets/translations"
+
+// Kind, AppLocalizationEnum template'inin template_util/generators altındaki klasörüdür
+const Kind = "locale"
+
+// Marker, locale komutlarının her seferinde yeniden ürettiği dosyaları işaretler
+const Marker = "flutter_assist locale"
+
+// DefaultLocaleKey, proje oluşturulurken seçilen varsayılan dilin değişken ismidir
+const DefaultLocaleKey = "DEFAULT_LOCALE"
+
+// Enum template'ine verilen değerler
+const (
+	// LocalesKey, enum değerleri: "  tr(Locale('tr')),"
+	LocalesKey = "LOCALES"
+	// PathKey, çeviri dosyalarının asset yolu
+	PathKey = "TRANSLATIONS_PATH"
+)
+
+var (
+	// languageCode, desteklenen dil kodu biçimi (useOnlyLangCode ile sadece dil kodu kullanılır)
+	languageCode = regexp.MustCompile(`^[a-z]{2,3}$`)
+	// translationKey, çeviri anahtarı biçimi: "home.title"
+	translationKey = regexp.MustCompile(`^[A-Za-z0-9_]+(\.[A-Za-z0-9_]+)*$`)
+)
+
+// dartKeywords, enum değeri olamayan ve dil kodu ile çakışan Dart anahtar kelimeleri
+var dartKeywords = map[string]bool{"as": true, "do": true, "if": true, "in": true, "is": true, "new": true, "var": true}
+
+// Report, dillerde eksik veya boş bırakılmış çeviri anahtarlarını tutar
+type Report struct {
+	Languages []string
+	// Keys, tüm dillerdeki anahtarların birleşimi
+	Keys []string
+	// Missing, dil kodu -> dosyada bulunmayan anahtarlar
+	Missing map[string][]string
+	// Empty, dil kodu -> değeri boş olan anahtarlar
+	Empty map[string][]string
+}
+
+// Count, eksik ve boş anahtarların toplam sayısını döndürür
+func (r *Report) Count() int {
+	count := 0
+	for _, lang := range r.Languages {
+		count += len(r.Missing[lang]) + len(r.Empty[lang])
+	}
+	return count
+}
+
+// CheckCode, dil kodunun geçerli olup olmadığını kontrol eder
+func CheckCode(code string) error {
+	if !languageCode.MatchString(code) {
+		return fmt.Errorf("geçersiz dil kodu: %q (tr, en gibi küçük harfli dil kodu olmalı)", code)
+	}
+	return nil
+}
+
+// Languages, çeviri klasöründeki dil kodlarını sıralı döndürür
+func Languages(root string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(root, TranslationsDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("çeviri klasörü okunamadı: %v", err)
+	}
+
+	var codes []string
+	for _, entry := range entries {
+		code, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !entry.IsDir() && ok && languageCode.MatchString(code) {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes, nil
+}
+
+// Init, verilen diller için çeviri dosyalarını ve AppLocalizationEnum dosyasını oluşturur,
+// çeviri klasörünü pubspec.yaml'a kaydeder. Mevcut çeviri dosyalarına dokunulmaz.
+// Oluşturulan veya güncellenen dosyalar döndürülür.
+func Init(p *generator.Project, codes []string, opts generator.Options) ([]string, error) {
+	existing, err := Languages(p.Root)
+	if err != nil {
+		return nil, err
+	}
+	all := append([]string{}, existing...)
+	for _, code := range codes {
+		if err := CheckCode(code); err != nil {
+			return nil, err
+		}
+		if !containsCode(all, code) {
+			all = append(all, code)
+		}
+	}
+	sort.Strings(all)
+
+	// Enum elle yazılmışsa hiçbir dil dosyası oluşturulmadan hata dönsün diye önce enum yazılır
+	changed, err := writeEnum(p, all, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var written []string
+	for _, code := range codes {
+		created, err := ensureLanguage(p.Root, code)
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			written = append(written, languageFile(code))
+		}
+	}
+
+	added, err := pubspec.AddAssets(filepath.Join(p.Root, pubspec.FileName), []string{TranslationsDir + "/"})
+	if err != nil {
+		return nil, err
+	}
+	if len(added) > 0 {
+		changed = append(changed, pubspec.FileName)
+	}
+	return append(written, changed...), nil
+}
+
+// AddLanguage, yeni bir dil ekler. Yeni dosya diğer dillerdeki tüm anahtarları boş değerlerle içerir
+func AddLanguage(p *generator.Project, code string, opts generator.Options) ([]string, error) {
+	if err := CheckCode(code); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(p.Root, languageFile(code))); err == nil {
+		return nil, fmt.Errorf("%s dili zaten mevcut", code)
+	}
+	return Init(p, []string{code}, opts)
+}
+
+// AddKey, anahtarı tüm dil dosyalarına ekler. values'da değeri verilmeyen diller için değer boş bırakılır.
+// Anahtar bazı dillerde zaten varsa sadece eksik olan dillere eklenir; güncellenen diller döndürülür.
+func AddKey(root string, key string, values map[string]string) ([]string, error) {
+	if !translationKey.MatchString(key) {
+		return nil, fmt.Errorf("geçersiz çeviri anahtarı: %q (home.title gibi olmalı)", key)
+	}
+
+	codes, err := Languages(root)
+	if err != nil {
+		return nil, err
+	}
+	if len(codes) == 0 {
+		return nil, fmt.Errorf("çeviri dosyası yok, önce 'locale init' veya 'locale add <kod>' çalıştırın")
+	}
+	for code := range values {
+		if !containsCode(codes, code) {
+			return nil, fmt.Errorf("%s dili bulunamadı", code)
+		}
+	}
+
+	// Önce tüm dosyalar okunup kontrol edilir, böylece çakışmada hiçbir dosya değişmez
+	files := make(map[string]*translations)
+	var updated []string
+	for _, code := range codes {
+		t, err := readTranslations(filepath.Join(root, languageFile(code)))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := t.lookup(key); ok {
+			continue
+		}
+		if err := t.set(key, values[code]); err != nil {
+			return nil, fmt.Errorf("%s: %v", languageFile(code), err)
+		}
+		files[code] = t
+		updated = append(updated, code)
+	}
+	if len(updated) == 0 {
+		return nil, fmt.Errorf("%s anahtarı tüm dillerde zaten mevcut", key)
+	}
+
+	for _, code := range updated {
+		if err := files[code].write(filepath.Join(root, languageFile(code))); err != nil {
+			return nil, err
+		}
+	}
+	return updated, nil
+}
+
+// Check, dillerde eksik veya boş çeviri anahtarlarını raporlar
+func Check(root string) (*Report, error) {
+	codes, err := Languages(root)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Languages: codes, Missing: map[string][]string{}, Empty: map[string][]string{}}
+	files := make(map[string]*translations)
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		t, err := readTranslations(filepath.Join(root, languageFile(code)))
+		if err != nil {
+			return nil, err
+		}
+		files[code] = t
+		for _, key := range t.leafKeys("") {
+			if !seen[key] {
+				seen[key] = true
+				report.Keys = append(report.Keys, key)
+			}
+		}
+	}
+
+	for _, code := range codes {
+		for _, key := range report.Keys {
+			value, ok := files[code].lookup(key)
+			switch {
+			case !ok:
+				report.Missing[code] = append(report.Missing[code], key)
+			case value == "":
+				report.Empty[code] = append(report.Empty[code], key)
+			}
+		}
+	}
+	return report, nil
+}
+
+// InitProject, flutter_assist ile oluşturulan projede varsayılan dil seçildiyse çeviri dosyalarını oluşturur
+func InitProject(projectRoot string) ([]string, error) {
+	config, err := project.LoadConfig(projectRoot)
+	if err != nil || config == nil || config.Values[DefaultLocaleKey] == "" {
+		return nil, err
+	}
+
+	p, err := generator.OpenProjectAt(projectRoot, nil)
+	if err != nil {
+		return nil, err
+	}
+	return Init(p, []string{config.Values[DefaultLocaleKey]}, generator.Options{})
+}
+
+// Fix, her dilde eksik olan anahtarları boş değerle ekler ve güncellenen dilleri döndürür
+func Fix(root string) ([]string, error) {
+	report, err := Check(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []string
+	for _, code := range report.Languages {
+		if len(report.Missing[code]) == 0 {
+			continue
+		}
+		filePath := filepath.Join(root, languageFile(code))
+		t, err := readTranslations(filePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range report.Missing[code] {
+			if err := t.set(key, ""); err != nil {
+				return nil, fmt.Errorf("%s: %v", languageFile(code), err)
+			}
+		}
+		if err := t.write(filePath); err != nil {
+			return nil, err
+		}
+		updated = append(updated, code)
+	}
+	return updated, nil
+}
+
+// ensureLanguage, dil dosyası yoksa diğer dillerdeki anahtarlarla oluşturur
+func ensureLanguage(root string, code string) (bool, error) {
+	filePath := filepath.Join(root, languageFile(code))
+	if _, err := os.Stat(filePath); err == nil {
+		return false, nil
+	}
+
+	report, err := Check(root)
+	if err != nil {
+		return false, err
+	}
+	t := newTranslations()
+	for _, key := range report.Keys {
+		if err := t.set(key, ""); err != nil {
+			return false, err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return false, fmt.Errorf("çeviri klasörü oluşturulamadı: %v", err)
+	}
+	return true, t.write(filePath)
+}
+
+// writeEnum, AppLocalizationEnum dosyasını verilen dillere göre yeniden üretir
+func writeEnum(p *generator.Project, codes []string, opts generator.Options) ([]string, error) {
+	var entries []string
+	for _, code := range codes {
+		entries = append(entries, fmt.Sprintf("  %s(Locale('%s')),", enumName(code), code))
+	}
+	files, err := p.RenderEach(Kind, []map[string]string{{
+		generator.NameKey: "app_localization_enum",
+		LocalesKey:        strings.Join(entries, "\n"),
+		PathKey:           TranslationsDir,
+	}}, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+	return p.WriteGenerated(files, Marker, opts)
+}
+
+// enumName, dil kodunun enum değeri olarak ismidir. Dart anahtar kelimeleri ile çakışanlara _ eklenir
+func enumName(code string) string {
+	if dartKeywords[code] {
+		return code + "_"
+	}
+	return code
+}
+
+// languageFile, dil dosyasının proje köküne göre yolunu döndürür
+func languageFile(code string) string {
+	return TranslationsDir + "/" + code + ".json"
+}
+
+// containsCode, dil kodunun listede olup olmadığını döndürür
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
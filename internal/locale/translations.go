@@ -0,0 +1,210 @@
+package locale
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySeparator, iç içe çeviri anahtarlarını ayıran karakterdir: "home.title"
+const KeySeparator = "."
+
+// translations, bir dil dosyasının içeriğini anahtar sırasını koruyarak tutar.
+// Değerler string, iç içe *translations veya olduğu gibi korunan json.RawMessage olabilir.
+type translations struct {
+	keys   []string
+	values map[string]any
+}
+
+func newTranslations() *translations {
+	return &translations{values: make(map[string]any)}
+}
+
+// readTranslations, dil dosyasını okur
+func readTranslations(filePath string) (*translations, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("çeviri dosyası okunamadı: %v", err)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return newTranslations(), nil
+	}
+
+	t, err := parseTranslations(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s JSON parse hatası: %v", filePath, err)
+	}
+	return t, nil
+}
+
+// parseTranslations, bir JSON nesnesini anahtar sırasını koruyarak okur
+func parseTranslations(data []byte) (*translations, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("çeviri dosyası bir JSON nesnesi olmalı")
+	}
+
+	t := newTranslations()
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := keyToken.(string)
+
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, err
+		}
+
+		var value any = raw
+		switch bytes.TrimSpace(raw)[0] {
+		case '{':
+			if value, err = parseTranslations(raw); err != nil {
+				return nil, err
+			}
+		case '"':
+			var text string
+			if err := json.Unmarshal(raw, &text); err != nil {
+				return nil, err
+			}
+			value = text
+		}
+
+		if _, ok := t.values[key]; !ok {
+			t.keys = append(t.keys, key)
+		}
+		t.values[key] = value
+	}
+	return t, nil
+}
+
+// write, dil dosyasını iki boşluk girintili JSON olarak yazar
+func (t *translations) write(filePath string) error {
+	var b bytes.Buffer
+	if err := t.encode(&b, ""); err != nil {
+		return err
+	}
+	b.WriteString("\n")
+	if err := os.WriteFile(filePath, b.Bytes(), 0644); err != nil {
+		return fmt.Errorf("çeviri dosyası kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+func (t *translations) encode(b *bytes.Buffer, indent string) error {
+	if len(t.keys) == 0 {
+		b.WriteString("{}")
+		return nil
+	}
+
+	b.WriteString("{\n")
+	for i, key := range t.keys {
+		b.WriteString(indent + "  ")
+		b.Write(encodeString(key))
+		b.WriteString(": ")
+
+		switch value := t.values[key].(type) {
+		case *translations:
+			if err := value.encode(b, indent+"  "); err != nil {
+				return err
+			}
+		case string:
+			b.Write(encodeString(value))
+		case json.RawMessage:
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, value); err != nil {
+				return err
+			}
+			b.Write(compact.Bytes())
+		}
+
+		if i < len(t.keys)-1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(indent + "}")
+	return nil
+}
+
+// encodeString, metni HTML kaçışı yapmadan JSON string'e çevirir
+func encodeString(value string) []byte {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(value)
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n"))
+}
+
+// leafKeys, tüm çeviri anahtarlarını "home.title" biçiminde dosyadaki sırasıyla döndürür
+func (t *translations) leafKeys(prefix string) []string {
+	var keys []string
+	for _, key := range t.keys {
+		if nested, ok := t.values[key].(*translations); ok {
+			keys = append(keys, nested.leafKeys(prefix+key+KeySeparator)...)
+			continue
+		}
+		keys = append(keys, prefix+key)
+	}
+	return keys
+}
+
+// lookup, anahtarın değerini döndürür
+func (t *translations) lookup(key string) (any, bool) {
+	current := t
+	parts := strings.Split(key, KeySeparator)
+	for i, part := range parts {
+		value, ok := current.values[part]
+		if !ok {
+			return nil, false
+		}
+		if i == len(parts)-1 {
+			return value, true
+		}
+		if current, ok = value.(*translations); !ok {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// set, anahtara değer atar ve gerekli ara nesneleri oluşturur.
+// Anahtarın bir kısmı metin olarak tanımlıysa veya anahtar bir nesneyse hata döner.
+func (t *translations) set(key string, value string) error {
+	current := t
+	parts := strings.Split(key, KeySeparator)
+	for i, part := range parts {
+		existing, ok := current.values[part]
+		if i == len(parts)-1 {
+			if _, isObject := existing.(*translations); ok && isObject {
+				return fmt.Errorf("%s bir anahtar grubu, metin atanamaz", key)
+			}
+			if !ok {
+				current.keys = append(current.keys, part)
+			}
+			current.values[part] = value
+			return nil
+		}
+
+		if !ok {
+			nested := newTranslations()
+			current.keys = append(current.keys, part)
+			current.values[part] = nested
+			current = nested
+			continue
+		}
+		nested, isObject := existing.(*translations)
+		if !isObject {
+			return fmt.Errorf("%s metin olarak tanımlı, altına anahtar eklenemez", strings.Join(parts[:i+1], KeySeparator))
+		}
+		current = nested
+	}
+	return nil
+}
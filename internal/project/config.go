@@ -0,0 +1,51 @@
+package project
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ConfigFileName, proje oluşturulurken seçilen type'ların ve değişken değerlerinin kaydedildiği dosyadır
+const ConfigFileName = ".flutter_assist.json"
+
+// Config, projenin flutter_assist ile oluşturulurken kullanılan ayarlarını tutar.
+// Generator'lar mimariyi bu dosyadaki type'lara göre seçer.
+type Config struct {
+	Types  []string          `json:"types"`
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// SaveConfig, proje ayarlarını proje kökündeki .flutter_assist.json dosyasına yazar
+func SaveConfig(projectRoot string, config Config) error {
+	if config.Types == nil {
+		config.Types = []string{}
+	}
+
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return fmt.Errorf("JSON dönüştürme hatası: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectRoot, ConfigFileName), data, 0644); err != nil {
+		return fmt.Errorf("proje ayarları kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+// LoadConfig, proje kökündeki .flutter_assist.json dosyasını okur. Dosya yoksa nil döner
+func LoadConfig(projectRoot string) (*Config, error) {
+	data, err := os.ReadFile(filepath.Join(projectRoot, ConfigFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("proje ayarları okunamadı: %v", err)
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("proje ayarları JSON parse hatası: %v", err)
+	}
+	return &config, nil
+}
@@ -7,6 +7,11 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"github.com/burak/flutter_assist/internal/pubspec"
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/source"
+	"github.com/burak/flutter_assist/internal/template"
 )
 
 // Package yapısı
@@ -26,10 +31,28 @@ type Project struct {
 type TemplateType struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	// Router, bu type seçilen projelerde "gen routes" komutunun kullanacağı router (go_router, auto_route)
+	Router string `json:"router,omitempty"`
+}
+
+// CreateOptions, proje oluşturma sırasında kullanılacak ek seçenekleri tutar
+type CreateOptions struct {
+	// Vars, --var ile verilen değişken değerleri
+	Vars map[string]string
+	// ValuesFile, --values ile verilen değerler dosyası
+	ValuesFile string
+}
+
+// selectedTemplate, proje için işlenecek bir template'i tutar
+type selectedTemplate struct {
+	Name     string
+	Template *template.Template
+	// Source, template git kaynağından geliyorsa kaynağın ismi
+	Source string
 }
 
 // CreateProject, yeni bir Flutter projesi oluşturur
-func CreateProject(projectName string, types []string) error {
+func CreateProject(projectName string, types []string, opts CreateOptions) error {
 	// Mevcut dizini al
 	currentDir := os.Getenv("PWD")
 	if currentDir == "" {
@@ -43,6 +66,29 @@ func CreateProject(projectName string, types []string) error {
 	}
 	execDir := filepath.Dir(execPath)
 
+	// Seçilen type'lara göre işlenecek template'leri belirle
+	templateDir := filepath.Join(execDir, "template_util", "templates")
+	templates, err := selectTemplates(templateDir, "", types)
+	if err != nil {
+		return err
+	}
+	sourced, err := sourceTemplates(types)
+	if err != nil {
+		return err
+	}
+	templates = append(templates, sourced...)
+
+	// Template değişkenlerinin değerlerini proje oluşturulmadan önce topla
+	renderCtx := render.NewContext(projectName, types)
+	renderCtx.LoadPartial = template.GetPartial
+	values, err := collectVariables(templates, opts)
+	if err != nil {
+		return err
+	}
+	for name, value := range values {
+		renderCtx.Set(name, value)
+	}
+
 	// Flutter projesi oluştur
 	fmt.Printf("ℹ️ Flutter projesi oluşturuluyor: %s\n", projectName)
 
@@ -68,6 +114,11 @@ func CreateProject(projectName string, types []string) error {
 	if err != nil {
 		return fmt.Errorf("paketler okunamadı: %v", err)
 	}
+	packagesFromSources, err := sourcePackages()
+	if err != nil {
+		return fmt.Errorf("kaynak paketleri okunamadı: %v", err)
+	}
+	allPackages = append(allPackages, packagesFromSources...)
 
 	// Seçilen type'lara göre paketleri filtrele
 	var filteredPackages []Package
@@ -98,48 +149,50 @@ func CreateProject(projectName string, types []string) error {
 		fmt.Printf("  ✅ %s paketi başarıyla eklendi\n", pkg.Name)
 	}
 
-	// Template dosyalarını oku ve oluştur
-	fmt.Printf("ℹ️ Template dosyaları oluşturuluyor...\n")
-	templateDir := filepath.Join(execDir, "template_util", "templates")
-	files, err := os.ReadDir(templateDir)
+	// {IF package:...} koşulları için eklenen paketleri oku
+	projectPackages, err := pubspec.Packages(pubspec.FileName)
 	if err != nil {
-		return fmt.Errorf("template klasörü okunamadı: %v", err)
+		return err
 	}
+	renderCtx.Packages = projectPackages
 
-	for _, file := range files {
-		if strings.HasSuffix(file.Name(), ".json") {
-			templatePath := filepath.Join(templateDir, file.Name())
-			data, err := os.ReadFile(templatePath)
-			if err != nil {
-				return fmt.Errorf("template dosyası okunamadı %s: %v", file.Name(), err)
-			}
-
-			var template struct {
-				Types []string `json:"types"`
-			}
-			if err := json.Unmarshal(data, &template); err != nil {
-				return fmt.Errorf("template JSON parse hatası %s: %v", file.Name(), err)
-			}
-
-			// Template'in type'larından herhangi biri seçilen type'larda varsa işle
-			shouldProcess := false
-			for _, templateType := range template.Types {
-				if contains(types, templateType) || templateType == "ALL" {
-					shouldProcess = true
-					break
-				}
+	// Template dosyalarını oluştur
+	fmt.Printf("ℹ️ Template dosyaları oluşturuluyor...\n")
+	var assetDirs []string
+	for _, selected := range templates {
+		fmt.Printf("  📄 %s template dosyası işleniyor...\n", selected.Name)
+		ctx := renderCtx
+		if selected.Source != "" {
+			ctx = sourceContext(renderCtx, selected.Source)
+		}
+		files, err := processTemplate(selected.Template, ctx)
+		if err != nil {
+			return fmt.Errorf("template işlenemedi %s: %v", selected.Name, err)
+		}
+		if selected.Template.Asset {
+			for _, file := range files {
+				assetDirs = append(assetDirs, filepath.ToSlash(filepath.Dir(file.Path))+"/")
 			}
+		}
+		fmt.Printf("  ✅ %s template dosyası başarıyla oluşturuldu\n", selected.Name)
+	}
 
-			if shouldProcess {
-				fmt.Printf("  📄 %s template dosyası işleniyor...\n", file.Name())
-				if err := processTemplate(templatePath, projectName); err != nil {
-					return fmt.Errorf("template işlenemedi %s: %v", file.Name(), err)
-				}
-				fmt.Printf("  ✅ %s template dosyası başarıyla oluşturuldu\n", file.Name())
-			}
+	// Asset klasörlerini pubspec.yaml'a kaydet
+	if len(assetDirs) > 0 {
+		added, err := pubspec.AddAssets(pubspec.FileName, assetDirs)
+		if err != nil {
+			return fmt.Errorf("asset klasörleri kaydedilemedi: %v", err)
+		}
+		for _, dir := range added {
+			fmt.Printf("  🖼️ %s asset klasörü pubspec.yaml dosyasına eklendi\n", dir)
 		}
 	}
 
+	// Generator'ların kullanması için seçilen type'ları ve değerleri kaydet
+	if err := SaveConfig(projectPath, Config{Types: types, Values: values}); err != nil {
+		return err
+	}
+
 	// Mevcut dizine geri dön
 	if err := os.Chdir(currentDir); err != nil {
 		return fmt.Errorf("mevcut dizine dönülemedi: %v", err)
@@ -150,6 +203,89 @@ func CreateProject(projectName string, types []string) error {
 	return nil
 }
 
+// selectTemplates, template klasöründeki template'lerden seçilen type'lara uyanları döndürür.
+// Kaynak ismi verilirse template type'ları kaynağın namespace'i ile eşleştirilir.
+func selectTemplates(templateDir string, sourceName string, types []string) ([]selectedTemplate, error) {
+	files, err := os.ReadDir(templateDir)
+	if err != nil {
+		return nil, fmt.Errorf("template klasörü okunamadı: %v", err)
+	}
+
+	var selected []selectedTemplate
+	for _, file := range files {
+		if !template.IsTemplateFile(file.Name()) {
+			continue
+		}
+
+		tpl, err := template.LoadTemplate(filepath.Join(templateDir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file.Name(), err)
+		}
+
+		// Template'in type'larından herhangi biri seçilen type'larda varsa işle
+		for _, templateType := range tpl.Types {
+			if contains(types, source.Qualify(sourceName, templateType)) || templateType == "ALL" && sourceName == "" {
+				selected = append(selected, selectedTemplate{Name: source.Qualify(sourceName, file.Name()), Template: tpl, Source: sourceName})
+				break
+			}
+		}
+	}
+
+	return selected, nil
+}
+
+// collectVariables, genel ve template'lere ait değişken tanımlarını birleştirip değerlerini toplar
+func collectVariables(templates []selectedTemplate, opts CreateOptions) (map[string]string, error) {
+	globals, err := template.GetVariables()
+	if err != nil {
+		return nil, err
+	}
+
+	groups := [][]template.Variable{globals}
+	for _, selected := range templates {
+		groups = append(groups, selected.Template.Variables)
+	}
+	variables, err := template.MergeVariables(groups...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Değerler dosyası önce, --var ile verilenler sonra uygulanır
+	provided := make(map[string]string)
+	if opts.ValuesFile != "" {
+		fileValues, err := template.ReadValuesFile(opts.ValuesFile)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range fileValues {
+			provided[name] = value
+		}
+	}
+	for name, value := range opts.Vars {
+		provided[name] = value
+	}
+
+	if len(variables) > 0 {
+		fmt.Printf("ℹ️ Template değişkenleri toplanıyor...\n")
+	}
+	values, err := template.ResolveVariables(variables, provided)
+	if err != nil {
+		return nil, err
+	}
+
+	// foreach kullanan template'lerin liste değişkeni tanımlı olmalı
+	for _, selected := range templates {
+		if selected.Template.ForEach == "" {
+			continue
+		}
+		if _, ok := values[selected.Template.ForEach]; !ok {
+			return nil, fmt.Errorf("%s template'i için foreach değişkeni tanımlı değil: %s", selected.Name, selected.Template.ForEach)
+		}
+	}
+
+	return values, nil
+}
+
 // readPackages, packages.json dosyasını okur
 func readPackages() ([]Package, error) {
 	data, err := os.ReadFile("template_util/packages.json")
@@ -165,35 +301,28 @@ func readPackages() ([]Package, error) {
 	return packages, nil
 }
 
-// processTemplate, bir template dosyasını işler
-func processTemplate(templatePath string, projectName string) error {
-	// Template dosyasını oku
-	data, err := os.ReadFile(templatePath)
+// processTemplate, bir template'i render edip proje içine yazar ve oluşturulan dosyaları döndürür
+func processTemplate(tpl *template.Template, ctx *render.Context) ([]template.RenderedFile, error) {
+	// Koşullu blokları işle, içerik ve çıktı yolundaki değişkenleri yerine koy
+	files, err := template.RenderFiles(tpl, ctx)
 	if err != nil {
-		return fmt.Errorf("template dosyası okunamadı: %v", err)
-	}
-
-	var template struct {
-		Path    string `json:"path"`
-		Content string `json:"content"`
-	}
-
-	if err := json.Unmarshal(data, &template); err != nil {
-		return fmt.Errorf("template JSON parse hatası: %v", err)
+		return nil, err
 	}
 
-	// {FLUTTER_ASSIST} yerine proje ismini koy
-	content := strings.ReplaceAll(template.Content, "{FLUTTER_ASSIST}", projectName)
+	for _, file := range files {
+		fmt.Printf("    📄 %s\n", filepath.ToSlash(file.Path))
+		// Klasörü oluştur
+		dir := filepath.Dir(file.Path)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("klasör oluşturulamadı: %v", err)
+		}
 
-	fmt.Println("filePath WARNING:", template.Path)
-	// Klasörü oluştur
-	dir := filepath.Dir(template.Path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("klasör oluşturulamadı: %v", err)
+		// Dosyayı oluştur
+		if err := os.WriteFile(file.Path, []byte(file.Content), 0644); err != nil {
+			return nil, fmt.Errorf("dosya oluşturulamadı: %v", err)
+		}
 	}
-
-	// Dosyayı oluştur
-	return os.WriteFile(template.Path, []byte(content), 0644)
+	return files, nil
 }
 
 func (p *Project) AddPackage(pkg Package) error {
@@ -331,7 +460,7 @@ func GetAllTemplates() ([]string, error) {
 
 	var templates []string
 	for _, file := range files {
-		if strings.HasSuffix(file.Name(), ".json") {
+		if template.IsTemplateFile(file.Name()) {
 			templates = append(templates, file.Name())
 		}
 	}
@@ -506,6 +635,37 @@ func AddPackage(packageName string, types []string) error {
 	return nil
 }
 
+// AddPackagesFromPubspec, pubspec.yaml içindeki bağımlılıkları seçilen type'lar için paket olarak kaydeder.
+// Zaten kayıtlı olan paketler atlanır, eklenen paketlerin isimleri döndürülür.
+func AddPackagesFromPubspec(pubspecPath string, types []string) ([]string, error) {
+	dependencies, err := pubspec.Dependencies(pubspecPath)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := GetPackages()
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool)
+	for _, pkg := range packages {
+		existing[pkg.Name] = true
+	}
+
+	var added []string
+	for _, name := range dependencies {
+		if existing[name] {
+			continue
+		}
+		if err := AddPackage(name, types); err != nil {
+			return added, err
+		}
+		added = append(added, name)
+	}
+
+	return added, nil
+}
+
 // AddType, yeni bir type ekler
 func AddType(typeName string, description string) error {
 	execPath, err := os.Executable()
@@ -619,7 +779,7 @@ func GetTemplates() ([]string, error) {
 
 	var templates []string
 	for _, file := range files {
-		if strings.HasSuffix(file.Name(), ".json") {
+		if template.IsTemplateFile(file.Name()) {
 			templates = append(templates, file.Name())
 		}
 	}
@@ -0,0 +1,126 @@
+package project
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/source"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// GetAllTypes, genel type'ları ve git kaynaklarının namespace'li type'larını döndürür
+func GetAllTypes() ([]TemplateType, error) {
+	types, err := GetTemplateTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := source.Load()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sources {
+		root, err := source.Root(s.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var result struct {
+			Types []TemplateType `json:"types"`
+		}
+		if err := readSourceFile(root, "template_for.json", &result); err != nil {
+			return nil, fmt.Errorf("%s: %v", s.Name, err)
+		}
+		for _, t := range result.Types {
+			t.Name = source.Qualify(s.Name, t.Name)
+			types = append(types, t)
+		}
+	}
+
+	return types, nil
+}
+
+// sourceTemplates, git kaynaklarındaki template'lerden seçilen namespace'li type'lara uyanları döndürür
+func sourceTemplates(types []string) ([]selectedTemplate, error) {
+	sources, err := source.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []selectedTemplate
+	for _, s := range sources {
+		root, err := source.Root(s.Name)
+		if err != nil {
+			return nil, err
+		}
+		templateDir := filepath.Join(root, "templates")
+		if _, err := os.Stat(templateDir); os.IsNotExist(err) {
+			continue
+		}
+
+		templates, err := selectTemplates(templateDir, s.Name, types)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", s.Name, err)
+		}
+		selected = append(selected, templates...)
+	}
+
+	return selected, nil
+}
+
+// sourcePackages, git kaynaklarındaki paketleri namespace'li type'ları ile döndürür
+func sourcePackages() ([]Package, error) {
+	sources, err := source.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []Package
+	for _, s := range sources {
+		root, err := source.Root(s.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var sourced []Package
+		if err := readSourceFile(root, "packages.json", &sourced); err != nil {
+			return nil, fmt.Errorf("%s: %v", s.Name, err)
+		}
+		for _, pkg := range sourced {
+			types := make([]string, len(pkg.Types))
+			for i, t := range pkg.Types {
+				types[i] = source.Qualify(s.Name, t)
+			}
+			packages = append(packages, Package{Name: pkg.Name, Types: types})
+		}
+	}
+
+	return packages, nil
+}
+
+// sourceContext, kaynak template'leri için type'ları namespace'siz gören ve
+// partial'ları önce kaynakta arayan bir context kopyası döndürür
+func sourceContext(ctx *render.Context, sourceName string) *render.Context {
+	clone := *ctx
+	clone.Types = source.LocalTypes(sourceName, ctx.Types)
+	clone.LoadPartial = template.PartialLoader(sourceName)
+	return &clone
+}
+
+// readSourceFile, kaynak kökündeki JSON dosyasını okur. Dosya yoksa değer boş kalır
+func readSourceFile(root string, name string, v interface{}) error {
+	data, err := os.ReadFile(filepath.Join(root, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s okunamadı: %v", name, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s parse hatası: %v", name, err)
+	}
+	return nil
+}
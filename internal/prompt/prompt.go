@@ -0,0 +1,20 @@
+package prompt
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// reader, tüm interaktif sorular için paylaşılan stdin reader'ı.
+// Her soru için ayrı reader açmak, stdin pipe ile verildiğinde sonraki cevapların kaybolmasına yol açar.
+var reader = bufio.NewReader(os.Stdin)
+
+// ReadLine, stdin'den bir satır okur ve boşluklarını temizleyerek döndürür
+func ReadLine() (string, error) {
+	input, err := reader.ReadString('\n')
+	if err != nil && input != "" {
+		err = nil
+	}
+	return strings.TrimSpace(input), err
+}
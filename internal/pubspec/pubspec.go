@@ -0,0 +1,551 @@
+package pubspec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileName, Flutter projelerindeki paket tanım dosyasının ismidir
+const FileName = "pubspec.yaml"
+
+// FindRoot, verilen yoldan başlayarak yukarı doğru pubspec.yaml bulunan ilk klasörü döndürür
+func FindRoot(start string) (string, error) {
+	dir, err := filepath.Abs(start)
+	if err != nil {
+		return "", fmt.Errorf("yol çözülemedi: %v", err)
+	}
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, FileName)); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("%s için Flutter projesi bulunamadı (pubspec.yaml yok)", start)
+		}
+		dir = parent
+	}
+}
+
+// Name, pubspec.yaml içindeki paket ismini döndürür
+func Name(pubspecPath string) (string, error) {
+	data, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return "", fmt.Errorf("pubspec.yaml okunamadı: %v", err)
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if indentOf(line) > 0 {
+			continue
+		}
+		if value, ok := strings.CutPrefix(strings.TrimSpace(line), "name:"); ok {
+			name := strings.Trim(strings.TrimSpace(stripComment(value)), `"'`)
+			if name != "" {
+				return name, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("pubspec.yaml içinde name alanı bulunamadı")
+}
+
+// Dependencies, dependencies bölümündeki paket isimlerini döndürür.
+// İsmi ile eklenemeyen sdk, path ve git bağımlılıkları atlanır.
+func Dependencies(pubspecPath string) ([]string, error) {
+	data, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return nil, fmt.Errorf("pubspec.yaml okunamadı: %v", err)
+	}
+	return childKeys(strings.Split(string(data), "\n"), "dependencies", true), nil
+}
+
+// Packages, dependencies ve dev_dependencies bölümlerindeki tüm paket isimlerini döndürür
+func Packages(pubspecPath string) ([]string, error) {
+	data, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return nil, fmt.Errorf("pubspec.yaml okunamadı: %v", err)
+	}
+	lines := strings.Split(string(data), "\n")
+	return append(childKeys(lines, "dependencies", false), childKeys(lines, "dev_dependencies", false)...), nil
+}
+
+// HasDependency, paketin dependencies bölümünde kayıtlı olup olmadığını döndürür.
+// sdk, path ve git bağımlılıkları da sayılır.
+func HasDependency(pubspecPath string, name string) (bool, error) {
+	data, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return false, fmt.Errorf("pubspec.yaml okunamadı: %v", err)
+	}
+	for _, key := range childKeys(strings.Split(string(data), "\n"), "dependencies", false) {
+		if key == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AddAssets, verilen asset yollarını pubspec.yaml içindeki flutter.assets listesine ekler.
+// Zaten kayıtlı olan yollar atlanır, eklenen yollar döndürülür.
+func AddAssets(pubspecPath string, assets []string) ([]string, error) {
+	data, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return nil, fmt.Errorf("pubspec.yaml okunamadı: %v", err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	existing := make(map[string]bool)
+	for _, asset := range listEntries(lines, "flutter", "assets") {
+		existing[asset] = true
+	}
+
+	var added []string
+	for _, asset := range assets {
+		if !existing[asset] {
+			existing[asset] = true
+			added = append(added, asset)
+		}
+	}
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	lines = insertListEntries(lines, "flutter", "assets", added)
+	if err := os.WriteFile(pubspecPath, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		return nil, fmt.Errorf("pubspec.yaml kaydedilemedi: %v", err)
+	}
+	return added, nil
+}
+
+// Assets, pubspec.yaml içindeki flutter.assets listesini döndürür
+func Assets(pubspecPath string) ([]string, error) {
+	data, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return nil, fmt.Errorf("pubspec.yaml okunamadı: %v", err)
+	}
+	return listEntries(strings.Split(string(data), "\n"), "flutter", "assets"), nil
+}
+
+// RemoveAssets, verilen asset yollarını flutter.assets listesinden siler.
+// Liste boşalırsa assets anahtarı da silinir, silinen yollar döndürülür.
+func RemoveAssets(pubspecPath string, assets []string) ([]string, error) {
+	data, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return nil, fmt.Errorf("pubspec.yaml okunamadı: %v", err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	start, end := sectionRange(lines, "flutter")
+	if start == -1 {
+		return nil, nil
+	}
+	keyLine := childKeyLine(lines, start, end, "assets")
+	if keyLine == -1 {
+		return nil, nil
+	}
+
+	remove := make(map[string]bool)
+	for _, asset := range assets {
+		remove[asset] = true
+	}
+
+	var removed []string
+	kept := 0
+	result := append([]string{}, lines[:keyLine+1]...)
+	i := keyLine + 1
+	for ; i < end; i++ {
+		entry, ok := listEntry(lines[i])
+		if !ok {
+			break
+		}
+		if remove[entry] {
+			removed = append(removed, entry)
+			continue
+		}
+		kept++
+		result = append(result, lines[i])
+	}
+	if len(removed) == 0 {
+		return nil, nil
+	}
+	if kept == 0 {
+		result = result[:len(result)-1]
+	}
+	result = append(result, lines[i:]...)
+
+	if err := os.WriteFile(pubspecPath, []byte(strings.Join(result, "\n")), 0644); err != nil {
+		return nil, fmt.Errorf("pubspec.yaml kaydedilemedi: %v", err)
+	}
+	return removed, nil
+}
+
+// Font, pubspec.yaml içindeki flutter.fonts listesinde bir font ailesidir
+type Font struct {
+	Family string
+	Files  []FontFile
+}
+
+// FontFile, font ailesine ait bir dosyadır. Weight 0 ise yazılmaz
+type FontFile struct {
+	Asset  string
+	Weight int
+	Italic bool
+}
+
+// SyncFonts, flutter.fonts listesini verilen font aileleri ile eşitler. Sadece tüm dosyaları dir klasörü
+// altında olan aileler yönetilir: listede olmayanlar silinir, dosyaları değişenler yeniden yazılır ve yeni
+// aileler eklenir. Dosyaları dir dışında olan ailelere dokunulmaz. Eklenen veya güncellenen aileler ile
+// silinen aileler döndürülür; fonts listesi boşalırsa anahtar da silinir.
+func SyncFonts(pubspecPath string, fonts []Font, dir string) ([]string, []string, error) {
+	data, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pubspec.yaml okunamadı: %v", err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	wanted := make(map[string]Font)
+	for _, font := range fonts {
+		if len(font.Files) > 0 {
+			wanted[font.Family] = font
+		}
+	}
+
+	keyLine, blockStart, blockStop := -1, 0, 0
+	if start, end := sectionRange(lines, "flutter"); start != -1 {
+		if keyLine = childKeyLine(lines, start, end, "fonts"); keyLine != -1 {
+			blockStart, blockStop = keyLine+1, blockEnd(lines, keyLine, end)
+		}
+	}
+
+	var changed, removed []string
+	var block []string
+	seen := make(map[string]bool)
+	indent := ""
+	if keyLine != -1 {
+		prefix, entries := fontEntries(lines[blockStart:blockStop])
+		block = append(block, prefix...)
+		for _, entry := range entries {
+			indent = entry[0][:indentOf(entry[0])]
+			family, assets := fontEntryValues(entry)
+			seen[family] = true
+			font, ok := wanted[family]
+			switch {
+			case !managedFont(assets, dir):
+				block = append(block, entry...)
+			case !ok:
+				removed = append(removed, family)
+			default:
+				generated := fontLines(font, indent)
+				if strings.Join(generated, "\n") != strings.Join(nonEmpty(entry), "\n") {
+					changed = append(changed, family)
+				}
+				block = append(block, generated...)
+			}
+		}
+	}
+
+	var added []string
+	for _, font := range fonts {
+		if len(font.Files) > 0 && !seen[font.Family] {
+			seen[font.Family] = true
+			changed = append(changed, font.Family)
+			added = append(added, fontLines(font, "")...)
+		}
+	}
+	if len(changed)+len(removed) == 0 {
+		return nil, nil, nil
+	}
+
+	if keyLine == -1 {
+		lines = insertBlock(lines, "flutter", "fonts", added)
+	} else {
+		for _, line := range added {
+			block = append(block, indent+line)
+		}
+		if len(nonEmpty(block)) == 0 {
+			// Liste boşaldıysa fonts anahtarı da silinir
+			lines = append(append([]string{}, lines[:keyLine]...), lines[blockStop:]...)
+		} else {
+			lines = append(append(append([]string{}, lines[:blockStart]...), block...), lines[blockStop:]...)
+		}
+	}
+
+	if err := os.WriteFile(pubspecPath, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		return nil, nil, fmt.Errorf("pubspec.yaml kaydedilemedi: %v", err)
+	}
+	return changed, removed, nil
+}
+
+// fontEntries, fonts listesinin satırlarını "- family:" ile başlayan ailelere böler.
+// İlk aileden önceki yorum satırları ayrıca döndürülür.
+func fontEntries(lines []string) ([]string, [][]string) {
+	listIndent := -1
+	var prefix []string
+	var entries [][]string
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "- ") && (listIndent == -1 || indentOf(line) == listIndent) {
+			listIndent = indentOf(line)
+			entries = append(entries, []string{line})
+			continue
+		}
+		if len(entries) == 0 {
+			prefix = append(prefix, line)
+			continue
+		}
+		entries[len(entries)-1] = append(entries[len(entries)-1], line)
+	}
+	return prefix, entries
+}
+
+// fontEntryValues, bir font ailesinin ismini ve dosyalarını döndürür
+func fontEntryValues(entry []string) (string, []string) {
+	var family string
+	var assets []string
+	for _, line := range entry {
+		trimmed := strings.TrimPrefix(strings.TrimSpace(line), "- ")
+		if value, ok := strings.CutPrefix(trimmed, "family:"); ok {
+			family = strings.Trim(strings.TrimSpace(stripComment(value)), `"'`)
+		}
+		if value, ok := strings.CutPrefix(trimmed, "asset:"); ok {
+			assets = append(assets, strings.Trim(strings.TrimSpace(stripComment(value)), `"'`))
+		}
+	}
+	return family, assets
+}
+
+// managedFont, ailenin tüm dosyalarının dir klasörü altında olup olmadığını döndürür
+func managedFont(assets []string, dir string) bool {
+	if len(assets) == 0 {
+		return false
+	}
+	for _, asset := range assets {
+		if !strings.HasPrefix(asset, dir+"/") {
+			return false
+		}
+	}
+	return true
+}
+
+// fontLines, font ailesinin fonts listesindeki satırlarını verilen girinti ile üretir
+func fontLines(font Font, indent string) []string {
+	lines := []string{indent + "- family: " + font.Family, indent + "  fonts:"}
+	for _, file := range font.Files {
+		lines = append(lines, indent+"    - asset: "+file.Asset)
+		if file.Weight != 0 {
+			lines = append(lines, fmt.Sprintf("%s      weight: %d", indent, file.Weight))
+		}
+		if file.Italic {
+			lines = append(lines, indent+"      style: italic")
+		}
+	}
+	return lines
+}
+
+// nonEmpty, boş olmayan satırları döndürür
+func nonEmpty(lines []string) []string {
+	var result []string
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// blockEnd, keyLine'daki anahtarın altındaki satırların bittiği satırı döndürür.
+// Sondaki boş satırlar bloğa dahil edilmez.
+func blockEnd(lines []string, keyLine, end int) int {
+	indent := indentOf(lines[keyLine])
+	last := keyLine + 1
+	for i := keyLine + 1; i < end; i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" {
+			continue
+		}
+		if indentOf(lines[i]) < indent || indentOf(lines[i]) == indent && !strings.HasPrefix(trimmed, "- ") {
+			break
+		}
+		last = i + 1
+	}
+	return last
+}
+
+// insertBlock, section.key altına girintisi anahtara göre verilmiş satırları ekler, gerekirse bölümü ve anahtarı oluşturur
+func insertBlock(lines []string, section, key string, block []string) []string {
+	start, end := sectionRange(lines, section)
+	if start == -1 {
+		// Dosya sonundaki boş satırları koru
+		for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+			lines = lines[:len(lines)-1]
+		}
+		lines = append(lines, "", section+":", "  "+key+":")
+		for _, line := range block {
+			lines = append(lines, "    "+line)
+		}
+		return append(lines, "")
+	}
+
+	indent := sectionIndent(lines, start, end)
+	keyLine := childKeyLine(lines, start, end, key)
+
+	var insertAt int
+	var newLines []string
+	if keyLine == -1 {
+		// Anahtarı bölümün son dolu satırından sonra ekle
+		insertAt = start + 1
+		for i := start + 1; i < end; i++ {
+			if strings.TrimSpace(lines[i]) != "" {
+				insertAt = i + 1
+			}
+		}
+		newLines = append(newLines, indent+key+":")
+	} else {
+		insertAt = blockEnd(lines, keyLine, end)
+	}
+	for _, line := range block {
+		newLines = append(newLines, indent+indent+line)
+	}
+
+	result := make([]string, 0, len(lines)+len(newLines))
+	result = append(result, lines[:insertAt]...)
+	result = append(result, newLines...)
+	return append(result, lines[insertAt:]...)
+}
+
+// sectionRange, en üst seviyedeki bir anahtarın satır aralığını döndürür. Bulunamazsa -1 döner
+func sectionRange(lines []string, section string) (int, int) {
+	start := -1
+	for i, line := range lines {
+		if start == -1 {
+			if strings.TrimRight(line, " \t\r") == section+":" {
+				start = i
+			}
+			continue
+		}
+		if isTopLevel(line) {
+			return start, i
+		}
+	}
+	if start == -1 {
+		return -1, -1
+	}
+	return start, len(lines)
+}
+
+// childKeys, bölümün doğrudan alt anahtarlarını döndürür. hostedOnly verilirse sdk, path veya git kaynaklı olanlar atlanır
+func childKeys(lines []string, section string, hostedOnly bool) []string {
+	start, end := sectionRange(lines, section)
+	if start == -1 {
+		return nil
+	}
+
+	indent := len(sectionIndent(lines, start, end))
+	var keys []string
+	for i := start + 1; i < end; i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || indentOf(line) != indent {
+			continue
+		}
+		key, _, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			continue
+		}
+
+		// Alt satırlarda sdk, path veya git tanımı varsa pub.dev paketi değildir
+		isHosted := true
+		for j := i + 1; j < end && (indentOf(lines[j]) > indent || strings.TrimSpace(lines[j]) == ""); j++ {
+			child := strings.TrimSpace(lines[j])
+			if strings.HasPrefix(child, "sdk:") || strings.HasPrefix(child, "path:") || strings.HasPrefix(child, "git:") {
+				isHosted = false
+			}
+		}
+		if isHosted || !hostedOnly {
+			keys = append(keys, strings.TrimSpace(key))
+		}
+	}
+	return keys
+}
+
+// childKeyLine, bölüm içindeki alt anahtarın satırını döndürür. Bulunamazsa -1 döner
+func childKeyLine(lines []string, start, end int, key string) int {
+	for i := start + 1; i < end; i++ {
+		if strings.TrimSpace(lines[i]) == key+":" && indentOf(lines[i]) > 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// listEntries, section.key altındaki liste elemanlarını döndürür
+func listEntries(lines []string, section, key string) []string {
+	start, end := sectionRange(lines, section)
+	if start == -1 {
+		return nil
+	}
+	keyLine := childKeyLine(lines, start, end, key)
+	if keyLine == -1 {
+		return nil
+	}
+
+	var entries []string
+	for i := keyLine + 1; i < end; i++ {
+		entry, ok := listEntry(lines[i])
+		if !ok {
+			break
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// insertListEntries, section.key listesinin sonuna yeni elemanlar ekler, gerekirse bölümü ve anahtarı oluşturur
+func insertListEntries(lines []string, section, key string, entries []string) []string {
+	block := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		block = append(block, "- "+entry)
+	}
+	return insertBlock(lines, section, key, block)
+}
+
+// sectionIndent, bölümdeki alt anahtarların girinti karakterlerini döndürür
+func sectionIndent(lines []string, start, end int) string {
+	for i := start + 1; i < end; i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		return lines[i][:indentOf(lines[i])]
+	}
+	return "  "
+}
+
+// listEntry, "    - assets/images/" gibi bir liste satırından elemanı döndürür
+func listEntry(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "- ") || indentOf(line) == 0 {
+		return "", false
+	}
+	entry := strings.TrimSpace(strings.TrimPrefix(trimmed, "- "))
+	return strings.Trim(entry, `"'`), true
+}
+
+func isTopLevel(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed != "" && !strings.HasPrefix(trimmed, "#") && indentOf(line) == 0
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
+func stripComment(value string) string {
+	if idx := strings.Index(value, " #"); idx != -1 {
+		return value[:idx]
+	}
+	return value
+}
@@ -0,0 +1,191 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/burak/flutter_assist/internal/bundle"
+)
+
+// ConfigName, varsayılan registry adresinin tutulduğu template_util dosyasıdır
+const ConfigName = "registry.json"
+
+// Client, bir template registry'si ile konuşur
+type Client struct {
+	URL  string
+	HTTP *http.Client
+}
+
+// NewClient, verilen adres için yeni bir client oluşturur
+func NewClient(url string) *Client {
+	return &Client{
+		URL:  strings.TrimSuffix(url, "/"),
+		HTTP: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// DefaultURL, template_util/registry.json dosyasındaki registry adresini döndürür
+func DefaultURL() (string, error) {
+	utilDir, err := bundle.UtilDir()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(filepath.Join(utilDir, ConfigName))
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("registry adresi ayarlanmamış, 'registry set <url>' veya -registry kullanın")
+	}
+	if err != nil {
+		return "", fmt.Errorf("registry ayarları okunamadı: %v", err)
+	}
+
+	var config struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", fmt.Errorf("registry ayarları JSON parse hatası: %v", err)
+	}
+	return config.URL, nil
+}
+
+// SetDefaultURL, varsayılan registry adresini template_util/registry.json dosyasına kaydeder
+func SetDefaultURL(url string) error {
+	utilDir, err := bundle.UtilDir()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(struct {
+		URL string `json:"url"`
+	}{URL: url}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("JSON dönüştürme hatası: %v", err)
+	}
+
+	if err := os.MkdirAll(utilDir, 0755); err != nil {
+		return fmt.Errorf("template util klasörü oluşturulamadı: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(utilDir, ConfigName), data, 0644); err != nil {
+		return fmt.Errorf("registry ayarları kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+// Index, registry'deki bundle listesini indirir
+func (c *Client) Index() (*Index, error) {
+	data, err := c.get(IndexPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var idx Index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("registry index JSON parse hatası: %v", err)
+	}
+	return &idx, nil
+}
+
+// Download, bundle'ı indirir, index'teki checksum ile doğrular ve açar
+func (c *Client) Download(entry *Entry) (*bundle.Bundle, error) {
+	data, err := c.get(BundlesPath + entry.File)
+	if err != nil {
+		return nil, err
+	}
+
+	if sum := bundle.Checksum(data); sum != entry.SHA256 {
+		return nil, fmt.Errorf("indirilen bundle'ın checksum'ı uyuşmuyor: %s (beklenen %s, bulunan %s)", entry.File, entry.SHA256, sum)
+	}
+	return bundle.Parse(entry.File, data)
+}
+
+// Publish, arşivi registry'ye yükler. İsim ve sürüm arşivin manifestinden okunur
+func (c *Client) Publish(archivePath string) (*Entry, error) {
+	b, err := bundle.Read(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckName(b.Manifest.Name, b.Manifest.Version); err != nil {
+		return nil, fmt.Errorf("%v (export -name ve -version ile belirtin)", err)
+	}
+
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("arşiv okunamadı: %v", err)
+	}
+
+	file := FileName(b.Manifest.Name, b.Manifest.Version, archiveExt(archivePath))
+	req, err := http.NewRequest(http.MethodPut, c.URL+BundlesPath+file, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("istek oluşturulamadı: %v", err)
+	}
+	req.Header.Set(ChecksumHeader, bundle.Checksum(data))
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("registry'ye bağlanılamadı: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("registry yanıtı okunamadı: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("yayınlama başarısız (%s): %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return nil, fmt.Errorf("registry yanıtı JSON parse hatası: %v", err)
+	}
+	return &entry, nil
+}
+
+// get, registry'den bir yolu indirir
+func (c *Client) get(path string) ([]byte, error) {
+	resp, err := c.HTTP.Get(c.URL + path)
+	if err != nil {
+		return nil, fmt.Errorf("registry'ye bağlanılamadı: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("registry yanıtı okunamadı: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s indirilemedi (%s): %s", path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
+
+// archiveExts, desteklenen arşiv uzantıları
+var archiveExts = []string{".tar.gz", ".tgz", ".zip"}
+
+// archiveExt, arşivin uzantısını döndürür (.tar.gz, .tgz veya .zip)
+func archiveExt(archivePath string) string {
+	for _, ext := range archiveExts {
+		if strings.HasSuffix(archivePath, ext) {
+			return ext
+		}
+	}
+	return filepath.Ext(archivePath)
+}
+
+// isArchive, dosyanın desteklenen bir arşiv olup olmadığını döndürür
+func isArchive(name string) bool {
+	for _, ext := range archiveExts {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
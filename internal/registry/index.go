@@ -0,0 +1,179 @@
+package registry
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IndexPath, registry'deki bundle listesinin yoludur
+const IndexPath = "/index.json"
+
+// BundlesPath, bundle dosyalarının indirildiği ve yayınlandığı yol önekidir
+const BundlesPath = "/bundles/"
+
+// ChecksumHeader, yayınlanan bundle'ın sha256 özetini taşıyan HTTP başlığıdır
+const ChecksumHeader = "X-Checksum-Sha256"
+
+// Entry, registry'deki bir bundle sürümünü tanımlar
+type Entry struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+	File        string `json:"file"`
+	Size        int    `json:"size"`
+	SHA256      string `json:"sha256"`
+	PublishedAt string `json:"published_at"`
+}
+
+// Index, registry'deki tüm bundle sürümlerini tutar
+type Index struct {
+	Bundles []Entry `json:"bundles"`
+}
+
+var (
+	bundleName    = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	bundleVersion = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z.+-]*$`)
+)
+
+// CheckName, bundle isim ve sürümünün registry'de kullanılabilir olduğunu kontrol eder
+func CheckName(name string, version string) error {
+	if !bundleName.MatchString(name) {
+		return fmt.Errorf("geçersiz bundle ismi: %q (küçük harf, rakam, - ve _ kullanılmalı)", name)
+	}
+	if !bundleVersion.MatchString(version) {
+		return fmt.Errorf("geçersiz bundle sürümü: %q", version)
+	}
+	return nil
+}
+
+// FileName, bundle sürümünün registry'deki dosya ismini döndürür
+func FileName(name string, version string, ext string) string {
+	return fmt.Sprintf("%s-%s%s", name, version, ext)
+}
+
+// Find, ismi verilen bundle'ın istenen sürümünü döndürür. Sürüm boşsa en son sürüm döner
+func (idx *Index) Find(name string, version string) (*Entry, error) {
+	var latest *Entry
+	for i := range idx.Bundles {
+		entry := &idx.Bundles[i]
+		if entry.Name != name {
+			continue
+		}
+		if version != "" {
+			if entry.Version == version {
+				return entry, nil
+			}
+			continue
+		}
+		if latest == nil || CompareVersions(entry.Version, latest.Version) > 0 {
+			latest = entry
+		}
+	}
+
+	if latest == nil {
+		if version != "" {
+			return nil, fmt.Errorf("bundle bulunamadı: %s@%s", name, version)
+		}
+		return nil, fmt.Errorf("bundle bulunamadı: %s", name)
+	}
+	return latest, nil
+}
+
+// Search, isminde veya açıklamasında sorgu geçen bundle'ların en son sürümlerini isme göre sıralı döndürür
+func (idx *Index) Search(query string) []Entry {
+	query = strings.ToLower(query)
+	latest := make(map[string]Entry)
+	for _, entry := range idx.Bundles {
+		text := strings.ToLower(entry.Name + " " + entry.Description)
+		if query != "" && !strings.Contains(text, query) {
+			continue
+		}
+		if current, ok := latest[entry.Name]; !ok || CompareVersions(entry.Version, current.Version) > 0 {
+			latest[entry.Name] = entry
+		}
+	}
+
+	results := make([]Entry, 0, len(latest))
+	for _, entry := range latest {
+		results = append(results, entry)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+	return results
+}
+
+// Versions, bundle'ın tüm sürümlerini eskiden yeniye sıralı döndürür
+func (idx *Index) Versions(name string) []string {
+	var versions []string
+	for _, entry := range idx.Bundles {
+		if entry.Name == name {
+			versions = append(versions, entry.Version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return CompareVersions(versions[i], versions[j]) < 0
+	})
+	return versions
+}
+
+// CompareVersions, "1.10.0" gibi noktalı sürümleri karşılaştırır. Önce "-" ve "+" öncesindeki
+// sürüm çekirdeği, sonra ön sürüm eki karşılaştırılır; ön sürüm aynı çekirdekli sürümden küçüktür
+// (1.0.0-beta < 1.0.0). "+" sonrasındaki derleme eki sadece diğer her şey eşitse sıralamayı belirler.
+func CompareVersions(a string, b string) int {
+	restA, buildA, _ := strings.Cut(a, "+")
+	restB, buildB, _ := strings.Cut(b, "+")
+	coreA, preA, hasPreA := strings.Cut(restA, "-")
+	coreB, preB, hasPreB := strings.Cut(restB, "-")
+
+	if c := compareParts(coreA, coreB); c != 0 {
+		return c
+	}
+	switch {
+	case hasPreA && !hasPreB:
+		return -1
+	case !hasPreA && hasPreB:
+		return 1
+	}
+	if c := compareParts(preA, preB); c != 0 {
+		return c
+	}
+	return compareParts(buildA, buildB)
+}
+
+// compareParts, noktalı parçaları sırayla karşılaştırır.
+// Sayısal parçalar sayı olarak, diğerleri metin olarak karşılaştırılır.
+func compareParts(a string, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		var pa, pb string
+		if i < len(partsA) {
+			pa = partsA[i]
+		}
+		if i < len(partsB) {
+			pb = partsB[i]
+		}
+
+		na, errA := strconv.Atoi(pa)
+		nb, errB := strconv.Atoi(pb)
+		switch {
+		case errA == nil && errB == nil:
+			if na != nb {
+				if na < nb {
+					return -1
+				}
+				return 1
+			}
+		case pa != pb:
+			if pa < pb {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,182 @@
+package registry_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/burak/flutter_assist/internal/bundle"
+	"github.com/burak/flutter_assist/internal/registry"
+)
+
+// writeBundle, test için tek template içeren bir arşiv oluşturur
+func writeBundle(t *testing.T, dir string, name string, version string) string {
+	t.Helper()
+	b := bundle.New(bundle.Manifest{Name: name, Version: version, Description: "ekip template'leri"}, map[string][]byte{
+		"templates/view.dart.tmpl": []byte("---\npath: lib/view.dart\n---\n// " + version + "\n"),
+	})
+	archivePath := filepath.Join(dir, registry.FileName(name, version, ".tar.gz"))
+	if _, err := b.Write(archivePath); err != nil {
+		t.Fatal(err)
+	}
+	return archivePath
+}
+
+// startRegistry, klasörü sunan bir test sunucusu ve ona bağlı client döndürür
+func startRegistry(t *testing.T, dir string) (*registry.Server, *registry.Client) {
+	t.Helper()
+	server, err := registry.NewServer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+	return server, registry.NewClient(ts.URL)
+}
+
+func TestPublishIndexDownload(t *testing.T) {
+	dir := t.TempDir()
+	server, client := startRegistry(t, filepath.Join(dir, "registry"))
+
+	for _, version := range []string{"1.0.0", "1.10.0", "1.2.0"} {
+		entry, err := client.Publish(writeBundle(t, dir, "ekip", version))
+		if err != nil {
+			t.Fatalf("publish %s: %v", version, err)
+		}
+		if entry.Name != "ekip" || entry.Version != version || entry.File != "ekip-"+version+".tar.gz" {
+			t.Fatalf("beklenmeyen entry: %+v", entry)
+		}
+	}
+	if server.Entries() != 3 {
+		t.Fatalf("3 sürüm bekleniyordu, bulunan %d", server.Entries())
+	}
+
+	idx, err := client.Index()
+	if err != nil {
+		t.Fatalf("index: %v", err)
+	}
+	entry, err := idx.Find("ekip", "")
+	if err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	if entry.Version != "1.10.0" {
+		t.Fatalf("en son sürüm 1.10.0 bekleniyordu, bulunan %s", entry.Version)
+	}
+
+	b, err := client.Download(entry)
+	if err != nil {
+		t.Fatalf("download: %v", err)
+	}
+	if b.Manifest.Version != "1.10.0" || !strings.Contains(string(b.Files["templates/view.dart.tmpl"]), "// 1.10.0") {
+		t.Fatalf("beklenmeyen bundle: %+v", b.Manifest)
+	}
+
+	// Yayınlanmış bir sürüm değiştirilemez
+	if _, err := client.Publish(writeBundle(t, dir, "ekip", "1.0.0")); err == nil || !strings.Contains(err.Error(), "zaten yayınlanmış") {
+		t.Fatalf("aynı sürüm tekrar yayınlanmamalıydı: %v", err)
+	}
+
+	// Index klasörde saklanır; silinirse arşivlerden yeniden oluşturulur
+	reloaded, err := registry.NewServer(server.Dir)
+	if err != nil || reloaded.Entries() != 3 {
+		t.Fatalf("index yeniden okunamadı: %v", err)
+	}
+	if err := os.Remove(filepath.Join(server.Dir, "index.json")); err != nil {
+		t.Fatal(err)
+	}
+	rebuilt, err := registry.NewServer(server.Dir)
+	if err != nil || rebuilt.Entries() != 3 {
+		t.Fatalf("index arşivlerden oluşturulamadı: %v", err)
+	}
+}
+
+func TestChecksumMismatch(t *testing.T) {
+	dir := t.TempDir()
+	_, client := startRegistry(t, filepath.Join(dir, "registry"))
+
+	entry, err := client.Publish(writeBundle(t, dir, "ekip", "1.0.0"))
+	if err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	// İndirilen arşiv index'teki checksum ile doğrulanır
+	tampered := *entry
+	tampered.SHA256 = strings.Repeat("0", 64)
+	if _, err := client.Download(&tampered); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Fatalf("checksum hatası bekleniyordu: %v", err)
+	}
+
+	// Sunucu, başlıktaki checksum'ı tutmayan yüklemeyi reddeder
+	data, err := os.ReadFile(writeBundle(t, dir, "ekip", "2.0.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPut, client.URL+registry.BundlesPath+"ekip-2.0.0.tar.gz", bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set(registry.ChecksumHeader, strings.Repeat("0", 64))
+	resp, err := client.HTTP.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("400 bekleniyordu, bulunan %s", resp.Status)
+	}
+
+	idx, err := client.Index()
+	if err != nil {
+		t.Fatalf("index: %v", err)
+	}
+	if _, err := idx.Find("ekip", "2.0.0"); err == nil {
+		t.Fatal("checksum'ı tutmayan sürüm index'e eklenmemeliydi")
+	}
+}
+
+func TestPublishRejectsInvalidName(t *testing.T) {
+	dir := t.TempDir()
+	_, client := startRegistry(t, filepath.Join(dir, "registry"))
+
+	if _, err := client.Publish(writeBundle(t, dir, "Ekip", "1.0.0")); err == nil {
+		t.Fatal("geçersiz isimle yayınlama reddedilmeliydi")
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.2.0", "1.10.0", -1},
+		{"2.0.0", "1.10.0", 1},
+		{"1.0.0-beta", "1.0.0", -1},
+		{"1.0.0", "1.0.0-rc.1", 1},
+		{"1.0.0-beta", "1.0.0-rc", -1},
+		{"1.0.0-rc.2", "1.0.0-rc.10", -1},
+		{"1.0.1-beta", "1.0.0", 1},
+		{"1.0.0+2", "1.0.0+1", 1},
+		{"1.0.0-beta+5", "1.0.0", -1},
+	}
+	for _, tt := range tests {
+		if got := registry.CompareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, beklenen %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	// En son sürüm olarak ön sürüm değil, aynı çekirdekli sürüm seçilir
+	idx := registry.Index{Bundles: []registry.Entry{
+		{Name: "ekip", Version: "1.0.0-beta"},
+		{Name: "ekip", Version: "1.0.0"},
+		{Name: "ekip", Version: "0.9.0"},
+	}}
+	entry, err := idx.Find("ekip", "")
+	if err != nil || entry.Version != "1.0.0" {
+		t.Fatalf("en son sürüm 1.0.0 bekleniyordu: %+v, %v", entry, err)
+	}
+}
@@ -0,0 +1,230 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/burak/flutter_assist/internal/bundle"
+)
+
+// indexFileName, sunulan klasördeki index dosyasının ismidir
+const indexFileName = "index.json"
+
+// maxBundleSize, yayınlanabilecek en büyük bundle boyutudur
+const maxBundleSize = 64 << 20
+
+// Server, bir klasördeki bundle'ları registry protokolü ile sunar.
+// index.json klasörde tutulur; yoksa klasördeki arşivlerin manifestlerinden oluşturulur.
+type Server struct {
+	Dir   string
+	mu    sync.Mutex
+	index Index
+}
+
+// NewServer, klasör için yeni bir registry sunucusu oluşturur
+func NewServer(dir string) (*Server, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("registry klasörü oluşturulamadı: %v", err)
+	}
+
+	s := &Server{Dir: dir}
+	data, err := os.ReadFile(filepath.Join(dir, indexFileName))
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &s.index); err != nil {
+			return nil, fmt.Errorf("registry index JSON parse hatası: %v", err)
+		}
+	case os.IsNotExist(err):
+		if err := s.rebuild(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("registry index okunamadı: %v", err)
+	}
+	return s, nil
+}
+
+// rebuild, klasördeki arşivleri okuyarak index'i yeniden oluşturur
+func (s *Server) rebuild() error {
+	files, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return fmt.Errorf("registry klasörü okunamadı: %v", err)
+	}
+
+	s.index = Index{Bundles: []Entry{}}
+	for _, file := range files {
+		if file.IsDir() || !isArchive(file.Name()) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(s.Dir, file.Name()))
+		if err != nil {
+			return fmt.Errorf("%s okunamadı: %v", file.Name(), err)
+		}
+		b, err := bundle.Parse(file.Name(), data)
+		if err != nil {
+			fmt.Printf("⚠️ %s atlandı: %v\n", file.Name(), err)
+			continue
+		}
+		if err := CheckName(b.Manifest.Name, b.Manifest.Version); err != nil {
+			fmt.Printf("⚠️ %s atlandı: %v\n", file.Name(), err)
+			continue
+		}
+
+		s.index.Bundles = append(s.index.Bundles, Entry{
+			Name:        b.Manifest.Name,
+			Version:     b.Manifest.Version,
+			Description: b.Manifest.Description,
+			File:        file.Name(),
+			Size:        len(data),
+			SHA256:      bundle.Checksum(data),
+			PublishedAt: b.Manifest.CreatedAt,
+		})
+	}
+	return s.saveIndex()
+}
+
+// saveIndex, index'i klasöre yazar
+func (s *Server) saveIndex() error {
+	data, err := json.MarshalIndent(s.index, "", "  ")
+	if err != nil {
+		return fmt.Errorf("JSON dönüştürme hatası: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(s.Dir, indexFileName), data, 0644); err != nil {
+		return fmt.Errorf("registry index kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+// Entries, sunulan bundle sayısını döndürür
+func (s *Server) Entries() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.index.Bundles)
+}
+
+// ServeHTTP, index, bundle indirme ve yayınlama isteklerini karşılar
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fmt.Printf("🌐 %s %s\n", r.Method, r.URL.Path)
+
+	switch {
+	case r.URL.Path == IndexPath && r.Method == http.MethodGet:
+		s.serveIndex(w)
+	case strings.HasPrefix(r.URL.Path, BundlesPath) && r.Method == http.MethodGet:
+		s.serveBundle(w, r)
+	case strings.HasPrefix(r.URL.Path, BundlesPath) && r.Method == http.MethodPut:
+		s.publish(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) serveIndex(w http.ResponseWriter) {
+	s.mu.Lock()
+	data, err := json.MarshalIndent(s.index, "", "  ")
+	s.mu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func (s *Server) serveBundle(w http.ResponseWriter, r *http.Request) {
+	file, ok := bundleFile(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	http.ServeFile(w, r, filepath.Join(s.Dir, file))
+}
+
+// publish, yüklenen bundle'ı doğrular, klasöre yazar ve index'e ekler.
+// Yayınlanmış bir sürüm değiştirilemez.
+func (s *Server) publish(w http.ResponseWriter, r *http.Request) {
+	file, ok := bundleFile(r.URL.Path)
+	if !ok {
+		http.Error(w, "geçersiz bundle yolu", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBundleSize+1))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("bundle okunamadı: %v", err), http.StatusBadRequest)
+		return
+	}
+	if len(data) > maxBundleSize {
+		http.Error(w, "bundle çok büyük", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	sum := bundle.Checksum(data)
+	if expected := r.Header.Get(ChecksumHeader); expected != sum {
+		http.Error(w, fmt.Sprintf("checksum uyuşmuyor (beklenen %s, bulunan %s)", expected, sum), http.StatusBadRequest)
+		return
+	}
+
+	b, err := bundle.Parse(file, data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := CheckName(b.Manifest.Name, b.Manifest.Version); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if expected := FileName(b.Manifest.Name, b.Manifest.Version, archiveExt(file)); file != expected {
+		http.Error(w, fmt.Sprintf("dosya ismi manifest ile uyuşmuyor, beklenen %s", expected), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.index.Find(b.Manifest.Name, b.Manifest.Version); err == nil {
+		http.Error(w, fmt.Sprintf("%s@%s zaten yayınlanmış", b.Manifest.Name, b.Manifest.Version), http.StatusConflict)
+		return
+	}
+
+	if err := os.WriteFile(filepath.Join(s.Dir, file), data, 0644); err != nil {
+		http.Error(w, fmt.Sprintf("bundle kaydedilemedi: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	entry := Entry{
+		Name:        b.Manifest.Name,
+		Version:     b.Manifest.Version,
+		Description: b.Manifest.Description,
+		File:        file,
+		Size:        len(data),
+		SHA256:      sum,
+		PublishedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	s.index.Bundles = append(s.index.Bundles, entry)
+	if err := s.saveIndex(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(entry)
+}
+
+// bundleFile, istek yolundaki bundle dosya ismini döndürür. Alt klasörlere izin verilmez
+func bundleFile(urlPath string) (string, bool) {
+	file := strings.TrimPrefix(urlPath, BundlesPath)
+	if file == "" || file != path.Base(file) || file == ".." || file == indexFileName {
+		return "", false
+	}
+	return file, true
+}
@@ -0,0 +1,99 @@
+package render
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Desteklenen isim dönüşümleri: {FLUTTER_ASSIST:pascal}, {API_BASE_URL:snake} ...
+var caseConverters = map[string]func(words []string) string{
+	"pascal": func(words []string) string {
+		return joinWords(words, "", title)
+	},
+	"camel": func(words []string) string {
+		s := joinWords(words, "", title)
+		if s == "" {
+			return s
+		}
+		return strings.ToLower(s[:1]) + s[1:]
+	},
+	"snake": func(words []string) string {
+		return joinWords(words, "_", strings.ToLower)
+	},
+	"kebab": func(words []string) string {
+		return joinWords(words, "-", strings.ToLower)
+	},
+	"constant": func(words []string) string {
+		return joinWords(words, "_", strings.ToUpper)
+	},
+	"dot": func(words []string) string {
+		return joinWords(words, ".", strings.ToLower)
+	},
+	"title": func(words []string) string {
+		return joinWords(words, " ", title)
+	},
+	"lower": func(words []string) string {
+		return joinWords(words, "", strings.ToLower)
+	},
+	"upper": func(words []string) string {
+		return joinWords(words, "", strings.ToUpper)
+	},
+}
+
+// ConvertCase, değeri verilen isim dönüşümüne göre çevirir. Bilinmeyen dönüşümde false döner
+func ConvertCase(value string, mode string) (string, bool) {
+	converter, ok := caseConverters[mode]
+	if !ok {
+		return "", false
+	}
+	return converter(splitWords(value)), true
+}
+
+// splitWords, "my_app", "my-app", "myApp" ve "MyApp" gibi değerleri kelimelere ayırır
+func splitWords(value string) []string {
+	var words []string
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = nil
+		}
+	}
+
+	runes := []rune(value)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && len(current) > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+
+	return words
+}
+
+func joinWords(words []string, sep string, transform func(string) string) string {
+	parts := make([]string, len(words))
+	for i, w := range words {
+		parts[i] = transform(w)
+	}
+	return strings.Join(parts, sep)
+}
+
+func title(word string) string {
+	if word == "" {
+		return word
+	}
+	runes := []rune(strings.ToLower(word))
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,222 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PartialLoader, ismi verilen partial'ın içeriğini döndürür
+type PartialLoader func(name string) (string, error)
+
+// Partial etiketleri: {INCLUDE header}, {EXTENDS base}, {BLOCK imports}...{ENDBLOCK}
+var (
+	includeTag = regexp.MustCompile(`\{INCLUDE ([A-Za-z0-9_./:-]+)\}`)
+	extendsTag = regexp.MustCompile(`^[ \t]*\{EXTENDS ([A-Za-z0-9_./:-]+)\}[ \t]*(\r?\n)?`)
+	blockTag   = regexp.MustCompile(`\{(BLOCK [A-Za-z0-9_]+|ENDBLOCK)\}`)
+)
+
+// block, içerikte bulunan bir {BLOCK} ... {ENDBLOCK} aralığını tutar
+type block struct {
+	name       string
+	start, end int // etiket satırları dahil tüm aralık
+	innerStart int
+	innerEnd   int
+}
+
+// resolvePartials, {EXTENDS} ve {INCLUDE} etiketlerini çözer ve blok etiketlerini temizler
+func resolvePartials(content string, ctx *Context) (string, error) {
+	if !includeTag.MatchString(content) && !extendsTag.MatchString(content) && !blockTag.MatchString(content) {
+		return content, nil
+	}
+
+	resolved, err := resolveWithStack(content, ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	return stripBlocks(resolved)
+}
+
+// resolveWithStack, çözümleme zincirini takip ederek döngüleri tespit eder
+func resolveWithStack(content string, ctx *Context, stack []string) (string, error) {
+	content, err := applyExtends(content, ctx, stack)
+	if err != nil {
+		return "", err
+	}
+	return applyIncludes(content, ctx, stack)
+}
+
+// loadPartial, partial'ı döngü kontrolü yaparak yükler
+func loadPartial(name string, ctx *Context, stack []string) (string, []string, error) {
+	for _, s := range stack {
+		if s == name {
+			return "", nil, fmt.Errorf("partial döngüsü tespit edildi: %s -> %s", strings.Join(stack, " -> "), name)
+		}
+	}
+	if strings.Contains(name, "..") {
+		return "", nil, fmt.Errorf("geçersiz partial ismi: %s", name)
+	}
+	if ctx.LoadPartial == nil {
+		return "", nil, fmt.Errorf("partial bulunamadı: %s", name)
+	}
+
+	content, err := ctx.LoadPartial(name)
+	if err != nil {
+		return "", nil, err
+	}
+	return content, append(append([]string{}, stack...), name), nil
+}
+
+// applyExtends, içerik bir base template'i genişletiyorsa base içindeki blokları override eder
+func applyExtends(content string, ctx *Context, stack []string) (string, error) {
+	m := extendsTag.FindStringSubmatchIndex(content)
+	if m == nil {
+		return content, nil
+	}
+
+	baseName := content[m[2]:m[3]]
+	child := content[m[1]:]
+
+	childBlocks, err := parseBlocks(child)
+	if err != nil {
+		return "", err
+	}
+	overrides := make(map[string]string)
+	for _, b := range childBlocks {
+		overrides[b.name] = child[b.innerStart:b.innerEnd]
+	}
+
+	base, baseStack, err := loadPartial(baseName, ctx, stack)
+	if err != nil {
+		return "", err
+	}
+
+	// Base de başka bir template'i genişletiyor olabilir
+	base, err = applyExtends(base, ctx, baseStack)
+	if err != nil {
+		return "", err
+	}
+
+	return overrideBlocks(base, overrides)
+}
+
+// overrideBlocks, içerikteki blokların içeriğini verilen override'lar ile değiştirir.
+// Blok etiketleri korunur, böylece zincirdeki bir üst template de aynı bloğu override edebilir.
+func overrideBlocks(content string, overrides map[string]string) (string, error) {
+	blocks, err := parseBlocks(content)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, blk := range blocks {
+		b.WriteString(content[last:blk.innerStart])
+		if override, ok := overrides[blk.name]; ok {
+			b.WriteString(override)
+		} else {
+			inner, err := overrideBlocks(content[blk.innerStart:blk.innerEnd], overrides)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(inner)
+		}
+		last = blk.innerEnd
+	}
+	b.WriteString(content[last:])
+	return b.String(), nil
+}
+
+// applyIncludes, {INCLUDE name} etiketlerini partial içerikleri ile değiştirir
+func applyIncludes(content string, ctx *Context, stack []string) (string, error) {
+	matches := includeTag.FindAllStringSubmatchIndex(content, -1)
+	if len(matches) == 0 {
+		return content, nil
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		name := content[m[2]:m[3]]
+		start, end := expandToLine(content, m[0], m[1])
+
+		partial, partialStack, err := loadPartial(name, ctx, stack)
+		if err != nil {
+			return "", err
+		}
+		partial, err = resolveWithStack(partial, ctx, partialStack)
+		if err != nil {
+			return "", err
+		}
+
+		// Satırın tamamı kaldırıldıysa partial'ın satır sonu ile bittiğinden emin ol
+		if end > m[1] && strings.HasSuffix(content[:end], "\n") && !strings.HasSuffix(partial, "\n") {
+			partial += "\n"
+		}
+
+		b.WriteString(content[last:start])
+		b.WriteString(partial)
+		last = end
+	}
+	b.WriteString(content[last:])
+	return b.String(), nil
+}
+
+// parseBlocks, içerikteki en üst seviye blokları bulur
+func parseBlocks(content string) ([]block, error) {
+	matches := blockTag.FindAllStringSubmatchIndex(content, -1)
+
+	var blocks []block
+	var open []block
+	for _, m := range matches {
+		tag := content[m[2]:m[3]]
+		start, end := expandToLine(content, m[0], m[1])
+
+		if tag == "ENDBLOCK" {
+			if len(open) == 0 {
+				return nil, fmt.Errorf("{ENDBLOCK} için eşleşen {BLOCK} bulunamadı")
+			}
+			blk := open[len(open)-1]
+			open = open[:len(open)-1]
+			blk.innerEnd = start
+			blk.end = end
+			if len(open) == 0 {
+				blocks = append(blocks, blk)
+			}
+			continue
+		}
+
+		open = append(open, block{
+			name:       strings.TrimPrefix(tag, "BLOCK "),
+			start:      start,
+			innerStart: end,
+		})
+	}
+
+	if len(open) != 0 {
+		return nil, fmt.Errorf("kapatılmamış blok: %s", open[len(open)-1].name)
+	}
+	return blocks, nil
+}
+
+// stripBlocks, çözümleme tamamlandıktan sonra blok etiketlerini içerikten kaldırır
+func stripBlocks(content string) (string, error) {
+	blocks, err := parseBlocks(content)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, blk := range blocks {
+		b.WriteString(content[last:blk.start])
+		inner, err := stripBlocks(content[blk.innerStart:blk.innerEnd])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(inner)
+		last = blk.end
+	}
+	b.WriteString(content[last:])
+	return b.String(), nil
+}
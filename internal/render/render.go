@@ -0,0 +1,277 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ProjectKey, template içeriğinde proje ismi yerine kullanılan anahtar kelimedir
+const ProjectKey = "{FLUTTER_ASSIST}"
+
+// Context, bir template render edilirken kullanılacak değerleri tutar
+type Context struct {
+	ProjectName string
+	Types       []string
+	// Packages, projenin pubspec.yaml dosyasındaki paketler. {IF package:bloc_test} koşullarında kullanılır
+	Packages    []string
+	Values      map[string]string
+	LoadPartial PartialLoader
+}
+
+// NewContext, proje ismi ve seçilen type'lar ile yeni bir render context'i oluşturur
+func NewContext(projectName string, types []string) *Context {
+	return &Context{
+		ProjectName: projectName,
+		Types:       types,
+		Values:      make(map[string]string),
+	}
+}
+
+// Set, context'e bir değişken değeri ekler
+func (c *Context) Set(name string, value string) {
+	if c.Values == nil {
+		c.Values = make(map[string]string)
+	}
+	c.Values[name] = value
+}
+
+// With, verilen değeri içeren yeni bir context kopyası döndürür
+func (c *Context) With(name string, value string) *Context {
+	clone := *c
+	clone.Values = make(map[string]string, len(c.Values)+1)
+	for k, v := range c.Values {
+		clone.Values[k] = v
+	}
+	clone.Values[name] = value
+	return &clone
+}
+
+// Lookup, verilen anahtarın değerini döndürür. FLUTTER_ASSIST her zaman proje ismidir
+func (c *Context) Lookup(name string) (string, bool) {
+	if name == projectKeyName {
+		return c.ProjectName, true
+	}
+	value, ok := c.Values[name]
+	return value, ok
+}
+
+// HasType, verilen type'ın seçili olup olmadığını döndürür. ALL her zaman seçilidir
+func (c *Context) HasType(name string) bool {
+	if name == "ALL" {
+		return true
+	}
+	for _, t := range c.Types {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPackage, verilen paketin projede bulunup bulunmadığını döndürür
+func (c *Context) HasPackage(name string) bool {
+	for _, p := range c.Packages {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Render, template içeriğindeki partial'ları, koşullu blokları ve anahtar kelimeleri işler
+func Render(content string, ctx *Context) (string, error) {
+	content, err := resolvePartials(content, ctx)
+	if err != nil {
+		return "", err
+	}
+
+	content, err = renderConditionals(content, ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return ReplaceValues(content, ctx), nil
+}
+
+const projectKeyName = "FLUTTER_ASSIST"
+
+// ItemKey, foreach ile çoğaltılan template'lerde o anki liste elemanının anahtarıdır
+const ItemKey = "ITEM"
+
+// Değişken etiketleri: {FLUTTER_ASSIST}, {API_BASE_URL}, {FLUTTER_ASSIST:pascal}
+var valueTag = regexp.MustCompile(`\{([A-Z][A-Z0-9_]*)(?::([a-z]+))?\}`)
+
+// ReservedNames, değişken ismi olarak kullanılamayacak anahtar kelimelerdir
+var ReservedNames = []string{projectKeyName, ItemKey, "IF", "ELSE", "ENDIF", "INCLUDE", "EXTENDS", "BLOCK", "ENDBLOCK"}
+
+// UnresolvedValues, içerikte context'te karşılığı olmayan değişken etiketlerini döndürür
+func UnresolvedValues(content string, ctx *Context) []string {
+	var unresolved []string
+	for _, m := range valueTag.FindAllStringSubmatch(content, -1) {
+		if _, ok := ctx.Lookup(m[1]); !ok {
+			unresolved = append(unresolved, m[0])
+		}
+	}
+	return unresolved
+}
+
+// ReplaceValues, içerikteki değişken etiketlerini context'teki değerler ile değiştirir.
+// Context'te olmayan anahtarlar ve bilinmeyen dönüşümler olduğu gibi bırakılır.
+func ReplaceValues(content string, ctx *Context) string {
+	return valueTag.ReplaceAllStringFunc(content, func(tag string) string {
+		m := valueTag.FindStringSubmatch(tag)
+		value, ok := ctx.Lookup(m[1])
+		if !ok {
+			return tag
+		}
+		if m[2] == "" {
+			return value
+		}
+		converted, ok := ConvertCase(value, m[2])
+		if !ok {
+			return tag
+		}
+		return converted
+	})
+}
+
+// Koşullu blok etiketleri: {IF FIREBASE}, {IF FIREBASE|REST_API}, {IF !FIREBASE}, {IF package:bloc_test}, {ELSE}, {ENDIF}
+var conditionalTag = regexp.MustCompile(`\{(IF [^{}\n]+|ELSE|ENDIF)\}`)
+
+// conditionalToken, içerikte bulunan bir koşul etiketini ve kapsadığı aralığı tutar
+type conditionalToken struct {
+	start, end int
+	kind       string
+	expr       string
+}
+
+// packagePrefix, koşullarda type yerine paket kontrolü yapan önek
+const packagePrefix = "package:"
+
+// renderConditionals, {IF ...}{ELSE}{ENDIF} bloklarını seçili type'lara göre işler
+func renderConditionals(content string, ctx *Context) (string, error) {
+	matches := conditionalTag.FindAllStringSubmatchIndex(content, -1)
+	if len(matches) == 0 {
+		return content, nil
+	}
+
+	tokens := make([]conditionalToken, 0, len(matches))
+	for _, m := range matches {
+		tag := content[m[2]:m[3]]
+		start, end := expandToLine(content, m[0], m[1])
+		token := conditionalToken{start: start, end: end, kind: tag}
+		if strings.HasPrefix(tag, "IF ") {
+			token.kind = "IF"
+			token.expr = strings.TrimSpace(strings.TrimPrefix(tag, "IF "))
+		}
+		tokens = append(tokens, token)
+	}
+
+	// Her seviye için: blok şu an aktif mi ve üst blok aktif mi
+	type frame struct {
+		parentActive bool
+		matched      bool
+		active       bool
+		seenElse     bool
+	}
+
+	var b strings.Builder
+	var stack []frame
+	active := true
+	last := 0
+
+	for _, token := range tokens {
+		if active {
+			b.WriteString(content[last:token.start])
+		}
+		last = token.end
+
+		switch token.kind {
+		case "IF":
+			result, err := evalCondition(token.expr, ctx)
+			if err != nil {
+				return "", err
+			}
+			stack = append(stack, frame{parentActive: active, matched: result, active: active && result})
+			active = active && result
+		case "ELSE":
+			if len(stack) == 0 {
+				return "", fmt.Errorf("{ELSE} için eşleşen {IF} bulunamadı")
+			}
+			top := &stack[len(stack)-1]
+			if top.seenElse {
+				return "", fmt.Errorf("aynı {IF} bloğunda birden fazla {ELSE} kullanılamaz")
+			}
+			top.seenElse = true
+			top.active = top.parentActive && !top.matched
+			active = top.active
+		case "ENDIF":
+			if len(stack) == 0 {
+				return "", fmt.Errorf("{ENDIF} için eşleşen {IF} bulunamadı")
+			}
+			active = stack[len(stack)-1].parentActive
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) != 0 {
+		return "", fmt.Errorf("kapatılmamış {IF} bloğu: %d adet {ENDIF} eksik", len(stack))
+	}
+
+	if active {
+		b.WriteString(content[last:])
+	}
+	return b.String(), nil
+}
+
+// evalCondition, bir koşul ifadesini değerlendirir.
+// "|" ile ayrılan gruplardan biri, "&" ile ayrılan type'ların hepsi seçiliyse true döner.
+// Başında "!" olan type seçili değilse sağlanır. "package:" ile başlayanlar type yerine projedeki paketlere bakar.
+func evalCondition(expr string, ctx *Context) (bool, error) {
+	for _, group := range strings.Split(expr, "|") {
+		matched := true
+		for _, name := range strings.Split(group, "&") {
+			name = strings.TrimSpace(name)
+			negate := strings.HasPrefix(name, "!")
+			name = strings.TrimSpace(strings.TrimPrefix(name, "!"))
+			if name == "" {
+				return false, fmt.Errorf("geçersiz koşul ifadesi: %q", expr)
+			}
+			var ok bool
+			if pkg, isPackage := strings.CutPrefix(name, packagePrefix); isPackage {
+				ok = ctx.HasPackage(strings.TrimSpace(pkg))
+			} else {
+				ok = ctx.HasType(name)
+			}
+			if ok == negate {
+				matched = false
+			}
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// expandToLine, etiket kendi satırında tek başınaysa aralığı tüm satırı kapsayacak şekilde genişletir.
+// Böylece import satırları gibi bloklar geriye boş satır bırakmadan kaldırılabilir.
+func expandToLine(content string, start, end int) (int, int) {
+	lineStart := strings.LastIndex(content[:start], "\n") + 1
+	if strings.TrimSpace(content[lineStart:start]) != "" {
+		return start, end
+	}
+
+	lineEnd := strings.Index(content[end:], "\n")
+	if lineEnd == -1 {
+		if strings.TrimSpace(content[end:]) != "" {
+			return start, end
+		}
+		return lineStart, len(content)
+	}
+	if strings.TrimSpace(content[end:end+lineEnd]) != "" {
+		return start, end
+	}
+	return lineStart, end + lineEnd + 1
+}
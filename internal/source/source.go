@@ -0,0 +1,347 @@
+package source
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// FileName, kayıtlı kaynakların tutulduğu template_util dosyasıdır
+const FileName = "sources.json"
+
+// cacheDirName, kaynakların klonlandığı template_util altındaki klasördür
+const cacheDirName = ".sources"
+
+// Separator, kaynak template ve type isimlerindeki namespace ayracıdır. Örn: ekip:FIREBASE
+const Separator = ":"
+
+// Source, template'leri bir git deposundan alınan kaynağı tanımlar
+type Source struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Ref, branch, tag veya commit. Boşsa deponun varsayılan branch'i kullanılır
+	Ref string `json:"ref,omitempty"`
+	// Commit, son güncellemede checkout edilen commit
+	Commit string `json:"commit,omitempty"`
+}
+
+var sourceName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// utilDir, çalıştırılabilir dosyanın yanındaki template_util klasörünü döndürür
+func utilDir() (string, error) {
+	execPath, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
+	}
+	return filepath.Join(filepath.Dir(execPath), "template_util"), nil
+}
+
+// Load, template_util/sources.json dosyasındaki kaynakları döndürür
+func Load() ([]Source, error) {
+	dir, err := utilDir()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	if os.IsNotExist(err) {
+		return []Source{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("kaynaklar dosyası okunamadı: %v", err)
+	}
+
+	var result struct {
+		Sources []Source `json:"sources"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("kaynaklar JSON parse hatası: %v", err)
+	}
+	return result.Sources, nil
+}
+
+// save, kaynakları template_util/sources.json dosyasına yazar
+func save(sources []Source) error {
+	dir, err := utilDir()
+	if err != nil {
+		return err
+	}
+
+	result := struct {
+		Sources []Source `json:"sources"`
+	}{Sources: sources}
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("JSON dönüştürme hatası: %v", err)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("template util klasörü oluşturulamadı: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, FileName), data, 0644); err != nil {
+		return fmt.Errorf("kaynaklar dosyası kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+// ParseSpec, "<url>@<ref>" değerini url ve ref olarak ayırır. Ref, adresin host kısmından sonraki
+// son "@" işaretinden ayrılır; böylece "git@github.com:ekip/repo.git" gibi SSH adreslerindeki
+// kullanıcı kısmı ref sanılmaz ve "release/1.0" gibi "/" içeren ref'ler desteklenir.
+func ParseSpec(spec string) (string, string, error) {
+	i := strings.LastIndex(spec, "@")
+	if i < pathStart(spec) {
+		return spec, "", nil
+	}
+
+	url, ref := spec[:i], spec[i+1:]
+	if url == "" || ref == "" || strings.ContainsAny(ref, " :~^?*[\\") || strings.Contains(ref, "..") {
+		return "", "", fmt.Errorf("geçersiz kaynak: %q (<git-url>@<ref> biçiminde olmalı)", spec)
+	}
+	return url, ref, nil
+}
+
+// pathStart, git adresinde host kısmından sonra yolun başladığı indeksi döndürür.
+// "https://user@host/yol", "user@host:yol" ve yerel yollar desteklenir.
+func pathStart(url string) int {
+	if i := strings.Index(url, "://"); i >= 0 {
+		if slash := strings.Index(url[i+3:], "/"); slash >= 0 {
+			return i + 3 + slash
+		}
+		return len(url)
+	}
+	// scp biçimi: "/" işaretinden önce gelen ilk ":" host ile yolu ayırır
+	colon := strings.Index(url, ":")
+	if colon > 0 && !strings.Contains(url[:colon], "/") {
+		return colon
+	}
+	return 0
+}
+
+// Split, "kaynak:isim" biçimindeki ismi kaynak ve isim olarak ayırır. Namespace yoksa kaynak boştur
+func Split(qualified string) (string, string) {
+	if i := strings.Index(qualified, Separator); i > 0 {
+		return qualified[:i], qualified[i+1:]
+	}
+	return "", qualified
+}
+
+// Qualify, isme kaynağın namespace'ini ekler
+func Qualify(sourceName string, name string) string {
+	if sourceName == "" {
+		return name
+	}
+	return sourceName + Separator + name
+}
+
+// LocalTypes, seçili type'lardan kaynağın kendi template'lerinde görünecek olanları döndürür.
+// Kaynağa ait type'ların namespace'i kaldırılır, genel type'lar olduğu gibi kalır.
+func LocalTypes(sourceName string, types []string) []string {
+	var local []string
+	for _, t := range types {
+		owner, name := Split(t)
+		switch owner {
+		case "":
+			local = append(local, t)
+		case sourceName:
+			local = append(local, name)
+		}
+	}
+	return local
+}
+
+// Root, kaynağın template_util yapısındaki kök klasörünü döndürür.
+// Depo kökünde template_util klasörü varsa o, yoksa deponun kendisi kullanılır.
+func Root(name string) (string, error) {
+	dir, err := cacheDir(name)
+	if err != nil {
+		return "", err
+	}
+	if info, err := os.Stat(filepath.Join(dir, "template_util")); err == nil && info.IsDir() {
+		return filepath.Join(dir, "template_util"), nil
+	}
+	return dir, nil
+}
+
+// cacheDir, kaynağın klonlandığı klasörü döndürür
+func cacheDir(name string) (string, error) {
+	dir, err := utilDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, cacheDirName, name), nil
+}
+
+// Add, git deposunu önbelleğe klonlar, ref'i checkout eder ve kaynağı kaydeder
+func Add(name string, spec string) (*Source, error) {
+	if !sourceName.MatchString(name) {
+		return nil, fmt.Errorf("geçersiz kaynak ismi: %q (küçük harf, rakam, - ve _ kullanılmalı)", name)
+	}
+
+	sources, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sources {
+		if s.Name == name {
+			return nil, fmt.Errorf("kaynak zaten mevcut: %s", name)
+		}
+	}
+
+	url, ref, err := ParseSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	src := Source{Name: name, URL: url, Ref: ref}
+
+	dir, err := cacheDir(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("kaynak önbelleği temizlenemedi: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return nil, fmt.Errorf("kaynak önbelleği oluşturulamadı: %v", err)
+	}
+	if err := git("", "clone", "--quiet", url, dir); err != nil {
+		return nil, err
+	}
+	if err := checkout(dir, &src); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	if err := save(append(sources, src)); err != nil {
+		return nil, err
+	}
+	return &src, nil
+}
+
+// Update, verilen kaynakları (boşsa tümünü) fetch edip ref'lerini yeniden checkout eder.
+// Önbelleği silinmiş kaynaklar yeniden klonlanır.
+func Update(names []string) ([]Source, error) {
+	sources, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []Source
+	for i := range sources {
+		if len(names) > 0 && !contains(names, sources[i].Name) {
+			continue
+		}
+
+		dir, err := cacheDir(sources[i].Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			err = git("", "clone", "--quiet", sources[i].URL, dir)
+		} else {
+			err = git(dir, "fetch", "--quiet", "--tags", "--force", "origin")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", sources[i].Name, err)
+		}
+		if err := checkout(dir, &sources[i]); err != nil {
+			return nil, fmt.Errorf("%s: %v", sources[i].Name, err)
+		}
+		updated = append(updated, sources[i])
+	}
+
+	for _, name := range names {
+		if !containsSource(updated, name) {
+			return nil, fmt.Errorf("kaynak bulunamadı: %s", name)
+		}
+	}
+
+	if err := save(sources); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Remove, kaynağı kayıtlardan ve önbellekten siler
+func Remove(name string) error {
+	sources, err := Load()
+	if err != nil {
+		return err
+	}
+
+	remaining := []Source{}
+	for _, s := range sources {
+		if s.Name != name {
+			remaining = append(remaining, s)
+		}
+	}
+	if len(remaining) == len(sources) {
+		return fmt.Errorf("kaynak bulunamadı: %s", name)
+	}
+
+	dir, err := cacheDir(name)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("kaynak önbelleği silinemedi: %v", err)
+	}
+	return save(remaining)
+}
+
+// checkout, kaynağın ref'ini commit'e çözer ve detached olarak checkout eder.
+// Branch'ler için uzak takip branch'i, tag ve commit'ler için ref'in kendisi kullanılır.
+func checkout(dir string, src *Source) error {
+	candidates := []string{"origin/HEAD"}
+	if src.Ref != "" {
+		candidates = []string{"origin/" + src.Ref, src.Ref}
+	}
+
+	for _, candidate := range candidates {
+		out, err := exec.Command("git", "-C", dir, "rev-parse", "--verify", "--quiet", candidate+"^{commit}").Output()
+		if err != nil {
+			continue
+		}
+		commit := strings.TrimSpace(string(out))
+		if err := git(dir, "checkout", "--quiet", "--detach", commit); err != nil {
+			return err
+		}
+		src.Commit = commit
+		return nil
+	}
+	return fmt.Errorf("ref bulunamadı: %s", src.Ref)
+}
+
+// git, git komutunu çalıştırır ve hata durumunda çıktısını döndürür
+func git(dir string, args ...string) error {
+	command := args[0]
+	if dir != "" {
+		args = append([]string{"-C", dir}, args...)
+	}
+	out, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git %s başarısız: %v\n%s", command, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func contains(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSource(sources []Source, name string) bool {
+	for _, s := range sources {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package source_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/source"
+	"github.com/burak/flutter_assist/internal/template"
+)
+
+// runGit, testteki git komutlarını kimlik ayarları ile çalıştırır
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+	if dir != "" {
+		args = append([]string{"-C", dir}, args...)
+	}
+	if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+		t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+}
+
+// pushPartial, çalışma kopyasındaki header partial'ını günceller ve bare depoya gönderir
+func pushPartial(t *testing.T, work string, content string) {
+	t.Helper()
+	partialDir := filepath.Join(work, "template_util", "partials")
+	if err := os.MkdirAll(partialDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(partialDir, "header.tmpl"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, work, "add", "-A")
+	runGit(t, work, "commit", "--quiet", "-m", content)
+	runGit(t, work, "push", "--quiet", "origin", "main")
+}
+
+// resolveHeader, kaynağın header partial'ını {INCLUDE ekip:header} ile render eder
+func resolveHeader(t *testing.T) string {
+	t.Helper()
+	ctx := render.NewContext("app", nil)
+	ctx.LoadPartial = template.GetPartial
+	rendered, err := render.Render("{INCLUDE ekip:header}", ctx)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	return strings.TrimSpace(rendered)
+}
+
+func TestAddUpdateResolve(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git bulunamadı")
+	}
+
+	// Kaynaklar test binary'sinin yanındaki template_util klasörüne yazılır
+	execPath, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	utilDir := filepath.Join(filepath.Dir(execPath), "template_util")
+	t.Cleanup(func() { os.RemoveAll(utilDir) })
+
+	bare := filepath.Join(t.TempDir(), "ekip.git")
+	runGit(t, "", "init", "--quiet", "--bare", bare)
+	runGit(t, "", "--git-dir", bare, "symbolic-ref", "HEAD", "refs/heads/main")
+
+	work := filepath.Join(t.TempDir(), "work")
+	runGit(t, "", "init", "--quiet", work)
+	runGit(t, work, "checkout", "--quiet", "-b", "main")
+	runGit(t, work, "remote", "add", "origin", bare)
+	pushPartial(t, work, "// v1\n")
+
+	added, err := source.Add("ekip", bare)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if added.Commit == "" {
+		t.Fatal("Add commit kaydetmedi")
+	}
+	if got := resolveHeader(t); got != "// v1" {
+		t.Fatalf("ilk partial = %q, beklenen %q", got, "// v1")
+	}
+
+	pushPartial(t, work, "// v2\n")
+	updated, err := source.Update([]string{"ekip"})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(updated) != 1 || updated[0].Commit == added.Commit {
+		t.Fatalf("Update yeni commit'i checkout etmedi: %+v", updated)
+	}
+	if got := resolveHeader(t); got != "// v2" {
+		t.Fatalf("güncellenen partial = %q, beklenen %q", got, "// v2")
+	}
+
+	if err := source.Remove("ekip"); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if _, err := template.GetPartial("ekip:header"); err == nil {
+		t.Fatal("silinen kaynağın partial'ı hala okunabiliyor")
+	}
+}
+
+func TestParseSpec(t *testing.T) {
+	tests := []struct {
+		spec, url, ref string
+		err            bool
+	}{
+		{spec: "git@github.com:ekip/repo.git", url: "git@github.com:ekip/repo.git"},
+		{spec: "git@github.com:ekip/repo.git@main", url: "git@github.com:ekip/repo.git", ref: "main"},
+		{spec: "git@github.com:ekip/repo.git@release/1.0", url: "git@github.com:ekip/repo.git", ref: "release/1.0"},
+		{spec: "https://github.com/ekip/repo.git@feature/x", url: "https://github.com/ekip/repo.git", ref: "feature/x"},
+		{spec: "https://user@github.com/ekip/repo.git", url: "https://user@github.com/ekip/repo.git"},
+		{spec: "https://user@github.com/ekip/repo.git@v1.2.0", url: "https://user@github.com/ekip/repo.git", ref: "v1.2.0"},
+		{spec: "/tmp/repo.git@main", url: "/tmp/repo.git", ref: "main"},
+		{spec: "/tmp/repo.git", url: "/tmp/repo.git"},
+		{spec: "git@github.com:ekip/repo.git@", err: true},
+		{spec: "https://github.com/ekip/repo.git@bad ref", err: true},
+	}
+	for _, tt := range tests {
+		url, ref, err := source.ParseSpec(tt.spec)
+		if tt.err {
+			if err == nil {
+				t.Errorf("ParseSpec(%q) hata vermeliydi", tt.spec)
+			}
+			continue
+		}
+		if err != nil || url != tt.url || ref != tt.ref {
+			t.Errorf("ParseSpec(%q) = %q, %q, %v; beklenen %q, %q", tt.spec, url, ref, err, tt.url, tt.ref)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffOp, satır bazlı farkta bir satırın durumunu belirtir
+type diffOp byte
+
+const (
+	diffEqual  diffOp = ' '
+	diffDelete diffOp = '-'
+	diffInsert diffOp = '+'
+)
+
+// diffLine, fark çıktısındaki tek bir satırı tutar
+type diffLine struct {
+	op   diffOp
+	text string
+}
+
+// UnifiedDiff, iki metin arasındaki farkı unified diff formatında döndürür. Fark yoksa boş string döner
+func UnifiedDiff(oldName, newName, oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+
+	lines := diffLines(splitLines(oldText), splitLines(newText))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+
+	const context = 3
+	oldLine, newLine := 1, 1
+	for i := 0; i < len(lines); {
+		if lines[i].op == diffEqual {
+			i++
+			oldLine++
+			newLine++
+			continue
+		}
+
+		// Değişikliğin öncesindeki bağlam satırları ile hunk başlat
+		start := i
+		for start > 0 && i-start < context && lines[start-1].op == diffEqual {
+			start--
+		}
+		hunkOld := oldLine - (i - start)
+		hunkNew := newLine - (i - start)
+
+		// Aralarında 2*context'ten az eşit satır olan değişiklikleri aynı hunk'ta birleştir
+		end := i
+		for end < len(lines) {
+			if lines[end].op != diffEqual {
+				end++
+				continue
+			}
+			run := end
+			for run < len(lines) && lines[run].op == diffEqual {
+				run++
+			}
+			if run == len(lines) || run-end > 2*context {
+				end += min(context, run-end)
+				break
+			}
+			end = run
+		}
+
+		oldCount, newCount := 0, 0
+		for _, l := range lines[start:end] {
+			if l.op != diffInsert {
+				oldCount++
+			}
+			if l.op != diffDelete {
+				newCount++
+			}
+		}
+
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", hunkOld, oldCount, hunkNew, newCount)
+		for _, l := range lines[start:end] {
+			fmt.Fprintf(&b, "%c%s\n", l.op, strings.TrimSuffix(l.text, "\n"))
+			if !strings.HasSuffix(l.text, "\n") {
+				b.WriteString("\\ No newline at end of file\n")
+			}
+		}
+
+		for _, l := range lines[i:end] {
+			if l.op != diffInsert {
+				oldLine++
+			}
+			if l.op != diffDelete {
+				newLine++
+			}
+		}
+		i = end
+	}
+
+	return b.String()
+}
+
+// diffLines, iki satır listesi arasındaki en uzun ortak alt diziyi kullanarak farkı hesaplar
+func diffLines(a, b []string) []diffLine {
+	// Ortak baş ve son satırları ayır, böylece tablo küçük kalır
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var result []diffLine
+	for _, line := range a[:prefix] {
+		result = append(result, diffLine{diffEqual, line})
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+
+	// lcs[i][j], midA[i:] ile midB[j:] arasındaki en uzun ortak alt dizi uzunluğu
+	lcs := make([][]int, len(midA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(midB)+1)
+	}
+	for i := len(midA) - 1; i >= 0; i-- {
+		for j := len(midB) - 1; j >= 0; j-- {
+			if midA[i] == midB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(midA) && j < len(midB) {
+		switch {
+		case midA[i] == midB[j]:
+			result = append(result, diffLine{diffEqual, midA[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, diffLine{diffDelete, midA[i]})
+			i++
+		default:
+			result = append(result, diffLine{diffInsert, midB[j]})
+			j++
+		}
+	}
+	for ; i < len(midA); i++ {
+		result = append(result, diffLine{diffDelete, midA[i]})
+	}
+	for ; j < len(midB); j++ {
+		result = append(result, diffLine{diffInsert, midB[j]})
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		result = append(result, diffLine{diffEqual, line})
+	}
+	return result
+}
+
+// splitLines, metni satır sonları korunarak satırlara ayırır. Böylece dosya sonundaki
+// satır sonu eksikliği de bir fark olarak görünür
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
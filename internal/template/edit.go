@@ -0,0 +1,109 @@
+package template
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/prompt"
+	"github.com/burak/flutter_assist/internal/render"
+)
+
+// EditTemplate, template içeriğini geçici bir dosyaya yazıp $EDITOR ile açar,
+// düzenlenen içeriği doğruladıktan sonra UpdateTemplate ile geri kaydeder.
+func EditTemplate(templateName string) error {
+	if err := checkWritable(templateName); err != nil {
+		return err
+	}
+	templateFile, err := FindTemplate(templateName)
+	if err != nil {
+		return err
+	}
+	templateName = TemplateName(filepath.Base(templateFile))
+	tpl, err := LoadTemplate(templateFile)
+	if err != nil {
+		return err
+	}
+	if tpl.IsBinary() {
+		return fmt.Errorf("binary template'ler düzenlenemez: %s", templateName)
+	}
+
+	// İçeriği gerçek dosya ismi ile geçici klasöre yaz, editör dil desteğini kullanabilsin
+	tempDir, err := os.MkdirTemp("", "flutter_assist_edit")
+	if err != nil {
+		return fmt.Errorf("geçici klasör oluşturulamadı: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	fileName := path.Base(filepath.ToSlash(tpl.Path))
+	if fileName == "." || fileName == "/" {
+		fileName = templateName
+	}
+	editFile := filepath.Join(tempDir, fileName)
+	if err := os.WriteFile(editFile, []byte(tpl.Content), 0644); err != nil {
+		return fmt.Errorf("geçici dosya yazılamadı: %v", err)
+	}
+
+	for {
+		if err := openEditor(editFile); err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(editFile)
+		if err != nil {
+			return fmt.Errorf("düzenlenen dosya okunamadı: %v", err)
+		}
+		content := string(data)
+
+		if content == tpl.Content {
+			fmt.Println("ℹ️ Değişiklik yapılmadı")
+			return nil
+		}
+
+		// Koşullu bloklar, partial'lar ve bloklar render edilebilir olmalı
+		if err := validateContent(content, tpl); err != nil {
+			fmt.Printf("❌ Template geçersiz: %v\n", err)
+			fmt.Print("ℹ️ Tekrar düzenlemek ister misiniz? (e/h): ")
+			answer, _ := prompt.ReadLine()
+			if strings.EqualFold(answer, "e") {
+				continue
+			}
+			return fmt.Errorf("değişiklikler kaydedilmedi")
+		}
+
+		return UpdateTemplate(templateName, content, tpl.Types)
+	}
+}
+
+// openEditor, $EDITOR (yoksa $VISUAL, o da yoksa vi) ile dosyayı açar ve kapanmasını bekler
+func openEditor(filePath string) error {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = os.Getenv("VISUAL")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	// "code -w" gibi argümanlı editör tanımlarını destekle
+	parts := strings.Fields(editor)
+	cmd := exec.Command(parts[0], append(parts[1:], filePath)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("editör çalıştırılamadı (%s): %v", editor, err)
+	}
+	return nil
+}
+
+// validateContent, içeriğin template'in type'ları ve varsayılan değerleri ile render edilebildiğini kontrol eder
+func validateContent(content string, tpl *Template) error {
+	ctx := render.NewContext("my_app", tpl.Types)
+	ctx.LoadPartial = GetPartial
+	_, err := render.Render(content, ctx)
+	return err
+}
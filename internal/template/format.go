@@ -0,0 +1,195 @@
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Template dosya formatları
+const (
+	// FormatJSON, içeriğin JSON string olarak saklandığı eski format
+	FormatJSON = "json"
+	// FormatSource, içeriğin olduğu gibi saklandığı, metadata'nın front-matter'da tutulduğu format
+	FormatSource = "tmpl"
+)
+
+// frontMatterDelimiter, kaynak formatındaki metadata bloğunu çevreleyen satır
+const frontMatterDelimiter = "---"
+
+// testDelimiter, kaynak formatında içeriği companion testten ayıran satır
+const testDelimiter = "--- test ---"
+
+// IsTemplateFile, dosya isminin desteklenen bir template formatına ait olup olmadığını döndürür
+func IsTemplateFile(fileName string) bool {
+	return formatOf(fileName) != ""
+}
+
+// TemplateName, template dosya isminden format uzantısını kaldırır
+func TemplateName(fileName string) string {
+	if format := formatOf(fileName); format != "" {
+		return strings.TrimSuffix(fileName, "."+format)
+	}
+	return fileName
+}
+
+// formatOf, dosya uzantısına göre template formatını döndürür
+func formatOf(fileName string) string {
+	switch {
+	case strings.HasSuffix(fileName, "."+FormatJSON):
+		return FormatJSON
+	case strings.HasSuffix(fileName, "."+FormatSource):
+		return FormatSource
+	default:
+		return ""
+	}
+}
+
+// LoadTemplate, verilen yoldaki template dosyasını formatına göre okur
+func LoadTemplate(templateFile string) (*Template, error) {
+	data, err := os.ReadFile(templateFile)
+	if err != nil {
+		return nil, fmt.Errorf("template dosyası okunamadı: %v", err)
+	}
+	return DecodeTemplate(templateFile, data)
+}
+
+// DecodeTemplate, dosya isminin belirttiği formattaki template içeriğini çözer
+func DecodeTemplate(templateFile string, data []byte) (*Template, error) {
+	var template Template
+	if formatOf(templateFile) == FormatSource {
+		if err := parseSource(data, &template); err != nil {
+			return nil, fmt.Errorf("template front-matter hatası: %v", err)
+		}
+		return &template, nil
+	}
+
+	if err := json.Unmarshal(data, &template); err != nil {
+		return nil, fmt.Errorf("template JSON parse hatası: %v", err)
+	}
+	return &template, nil
+}
+
+// SaveTemplate, template'i dosya uzantısının belirttiği formatta kaydeder
+func SaveTemplate(templateFile string, template *Template) error {
+	data, err := EncodeTemplate(templateFile, template)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(templateFile, data, 0644); err != nil {
+		return fmt.Errorf("template dosyası kaydedilemedi: %v", err)
+	}
+	return nil
+}
+
+// EncodeTemplate, template'i dosya isminin belirttiği formatta içeriğe dönüştürür
+func EncodeTemplate(templateFile string, template *Template) ([]byte, error) {
+	var data []byte
+	var err error
+	if formatOf(templateFile) == FormatSource {
+		data, err = formatSource(template)
+	} else {
+		data, err = json.MarshalIndent(template, "", "  ")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("template dönüştürme hatası: %v", err)
+	}
+	return data, nil
+}
+
+// ConvertTemplate, template'i diğer formata çevirir ve eski dosyayı siler. Yeni dosyanın yolunu döndürür
+func ConvertTemplate(templateFile string, format string) (string, error) {
+	if format != FormatJSON && format != FormatSource {
+		return "", fmt.Errorf("geçersiz format: %s (json veya tmpl olmalı)", format)
+	}
+	if formatOf(templateFile) == format {
+		return templateFile, nil
+	}
+
+	template, err := LoadTemplate(templateFile)
+	if err != nil {
+		return "", err
+	}
+
+	newFile := filepath.Join(filepath.Dir(templateFile), TemplateName(filepath.Base(templateFile))+"."+format)
+	if _, err := os.Stat(newFile); err == nil {
+		return "", fmt.Errorf("hedef dosya zaten mevcut: %s", filepath.Base(newFile))
+	}
+
+	if err := SaveTemplate(newFile, template); err != nil {
+		return "", err
+	}
+	if err := os.Remove(templateFile); err != nil {
+		return "", fmt.Errorf("eski template dosyası silinemedi: %v", err)
+	}
+	return newFile, nil
+}
+
+// sourceHeader, front-matter'da yazılacak alanları tutar.
+// Content ve Test alanları gömülü template'in alanlarını gizler, böylece içerik ve test sadece gövdede bulunur.
+type sourceHeader struct {
+	*Template
+	Content string `json:"content,omitempty"`
+	Test    string `json:"test,omitempty"`
+}
+
+// formatSource, template'i front-matter + ham içerik olarak yazar. Companion test varsa
+// içerikten sonra "--- test ---" satırı ile ayrılır:
+//
+//	---
+//	{ "path": "...", "types": ["ALL"] }
+//	---
+//	<içerik>
+//	--- test ---
+//	<test>
+func formatSource(template *Template) ([]byte, error) {
+	header, err := json.MarshalIndent(sourceHeader{Template: template}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.WriteString(frontMatterDelimiter + "\n")
+	b.Write(header)
+	b.WriteString("\n" + frontMatterDelimiter + "\n")
+	b.WriteString(template.Content)
+	if template.Test != "" {
+		if !strings.HasSuffix(template.Content, "\n") {
+			b.WriteString("\n")
+		}
+		b.WriteString(testDelimiter + "\n")
+		b.WriteString(template.Test)
+	}
+	return b.Bytes(), nil
+}
+
+// parseSource, front-matter + ham içerik formatındaki template'i okur
+func parseSource(data []byte, template *Template) error {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if !strings.HasPrefix(text, frontMatterDelimiter+"\n") {
+		return fmt.Errorf("dosya %s satırı ile başlamalı", frontMatterDelimiter)
+	}
+	rest := strings.TrimPrefix(text, frontMatterDelimiter+"\n")
+
+	end := strings.Index(rest, "\n"+frontMatterDelimiter+"\n")
+	if end == -1 {
+		return fmt.Errorf("kapanış %s satırı bulunamadı", frontMatterDelimiter)
+	}
+
+	if err := json.Unmarshal([]byte(rest[:end]), template); err != nil {
+		return fmt.Errorf("metadata JSON parse hatası: %v", err)
+	}
+	body := rest[end+len(frontMatterDelimiter)+2:]
+	if strings.HasPrefix(body, testDelimiter+"\n") {
+		template.Content, template.Test = "", strings.TrimPrefix(body, testDelimiter+"\n")
+	} else if content, test, ok := strings.Cut(body, "\n"+testDelimiter+"\n"); ok {
+		template.Content, template.Test = content+"\n", test
+	} else {
+		template.Content = body
+	}
+	return nil
+}
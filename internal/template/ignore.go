@@ -0,0 +1,180 @@
+package template
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// IgnoreFileName, klasör yakalanırken okunan ignore dosyasının ismidir
+const IgnoreFileName = ".flutterassistignore"
+
+// defaultIgnorePatterns, her klasör yakalamada varsayılan olarak atlanan yollar
+var defaultIgnorePatterns = []string{
+	".git/",
+	".dart_tool/",
+	".idea/",
+	".vscode/",
+	"build/",
+	".DS_Store",
+	".flutter-plugins",
+	".flutter-plugins-dependencies",
+	".packages",
+	"*.iml",
+	"*.g.dart",
+	"*.freezed.dart",
+	IgnoreFileName,
+}
+
+// ignorePattern, gitignore benzeri tek bir kuralı tutar
+type ignorePattern struct {
+	source  string
+	re      *regexp.Regexp
+	dirOnly bool
+	negate  bool
+}
+
+// fileFilter, yakalanacak dosyaları ignore kuralları ve include/exclude glob'ları ile filtreler
+type fileFilter struct {
+	ignore  []ignorePattern
+	include []ignorePattern
+	exclude []ignorePattern
+}
+
+// newFileFilter, varsayılan kurallar, root içindeki .flutterassistignore ve verilen glob'lar ile filtre oluşturur
+func newFileFilter(root string, include, exclude []string) (*fileFilter, error) {
+	filter := &fileFilter{}
+
+	patterns := append([]string{}, defaultIgnorePatterns...)
+	ignoreFile := filepath.Join(root, IgnoreFileName)
+	if file, err := os.Open(ignoreFile); err == nil {
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			patterns = append(patterns, scanner.Text())
+		}
+		file.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("%s okunamadı: %v", IgnoreFileName, err)
+		}
+	}
+
+	for _, line := range patterns {
+		if p, ok := compilePattern(line); ok {
+			filter.ignore = append(filter.ignore, p)
+		}
+	}
+	for _, glob := range include {
+		if p, ok := compilePattern(glob); ok {
+			filter.include = append(filter.include, p)
+		}
+	}
+	for _, glob := range exclude {
+		if p, ok := compilePattern(glob); ok {
+			filter.exclude = append(filter.exclude, p)
+		}
+	}
+
+	return filter, nil
+}
+
+// skipReason, yol atlanacaksa sebebini, yakalanacaksa boş string döndürür.
+// relPath root'a göre "/" ayraçlı yoldur.
+func (f *fileFilter) skipReason(relPath string, isDir bool) string {
+	ignored := ""
+	for _, p := range f.ignore {
+		if p.matches(relPath, isDir) {
+			if p.negate {
+				ignored = ""
+			} else {
+				ignored = "ignore: " + p.source
+			}
+		}
+	}
+	if ignored != "" {
+		return ignored
+	}
+
+	for _, p := range f.exclude {
+		if p.matches(relPath, isDir) {
+			return "exclude: " + p.source
+		}
+	}
+
+	// Include glob'ları sadece dosyalara uygulanır, klasörler gezilmeye devam eder
+	if !isDir && len(f.include) > 0 {
+		for _, p := range f.include {
+			if p.matches(relPath, false) {
+				return ""
+			}
+		}
+		return "include dışında"
+	}
+
+	return ""
+}
+
+// matches, kuralın verilen yola uyup uymadığını döndürür
+func (p ignorePattern) matches(relPath string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+	return p.re.MatchString(relPath)
+}
+
+// compilePattern, gitignore söz dizimindeki bir satırı regex'e çevirir.
+// "/" içermeyen kurallar her seviyedeki isimlere uyar, "dir/" sadece klasörlere uyar,
+// "**" birden fazla klasörü, "*" ve "?" tek bir yol parçasını kapsar.
+func compilePattern(line string) (ignorePattern, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignorePattern{}, false
+	}
+
+	p := ignorePattern{source: line}
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = strings.TrimPrefix(line, "!")
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("(^|/)")
+	}
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '*' && strings.HasPrefix(line[i:], "**/"):
+			b.WriteString("(.*/)?")
+			i += 2
+		case c == '*' && strings.HasPrefix(line[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return ignorePattern{}, false
+	}
+	p.re = re
+	return p, true
+}
@@ -0,0 +1,128 @@
+package template
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/render"
+)
+
+// RenderedFile, render edilmiş bir template çıktısını tutar
+type RenderedFile struct {
+	Path    string
+	Content string
+	Binary  bool
+	// Test, dosyanın template'in companion testi olup olmadığını belirtir
+	Test bool
+}
+
+// RenderFiles, template'i verilen context ile render eder.
+// Template bir liste değişkeni üzerinde foreach tanımlıyorsa her eleman için ayrı bir dosya üretilir.
+// Companion testi olan template'lerde her dosyanın testi dosyadan hemen sonra gelir.
+func RenderFiles(tpl *Template, ctx *render.Context) ([]RenderedFile, error) {
+	if tpl.ForEach == "" {
+		return renderWithTest(tpl, ctx)
+	}
+
+	list, ok := ctx.Lookup(tpl.ForEach)
+	if !ok {
+		return nil, fmt.Errorf("foreach değişkeni bulunamadı: %s", tpl.ForEach)
+	}
+
+	var files []RenderedFile
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		rendered, err := renderWithTest(tpl, ctx.With(render.ItemKey, item))
+		if err != nil {
+			return nil, fmt.Errorf("%s=%s: %v", tpl.ForEach, item, err)
+		}
+		files = append(files, rendered...)
+	}
+	return files, nil
+}
+
+// renderWithTest, template'i ve varsa companion testini aynı context ile render eder
+func renderWithTest(tpl *Template, ctx *render.Context) ([]RenderedFile, error) {
+	file, err := renderFile(tpl, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tpl.Test == "" {
+		return []RenderedFile{file}, nil
+	}
+	if tpl.IsBinary() {
+		return nil, fmt.Errorf("binary template'lerin companion testi olamaz")
+	}
+
+	testPath, err := TestPath(file.Path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := render.Render(tpl.Test, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("companion test render edilemedi: %v", err)
+	}
+	return []RenderedFile{file, {Path: testPath, Content: content, Test: true}}, nil
+}
+
+// TestPath, lib/ altındaki bir dart dosyasının test/ altındaki karşılığını döndürür:
+// lib/feature/home/view/home_view.dart -> test/feature/home/view/home_view_test.dart
+func TestPath(filePath string) (string, error) {
+	slashed := filepath.ToSlash(filePath)
+	rest, ok := strings.CutPrefix(slashed, "lib/")
+	if !ok || !strings.HasSuffix(rest, ".dart") {
+		return "", fmt.Errorf("companion test sadece lib/ altındaki .dart dosyaları için tanımlanabilir: %s", slashed)
+	}
+	return filepath.FromSlash("test/" + strings.TrimSuffix(rest, ".dart") + "_test.dart"), nil
+}
+
+// renderFile, template'in içeriğini ve çıktı yolunu aynı context ile render eder.
+// Binary template'lerin içeriği render edilmeden byte byte geri yüklenir.
+func renderFile(tpl *Template, ctx *render.Context) (RenderedFile, error) {
+	var content string
+	if tpl.IsBinary() {
+		data, err := base64.StdEncoding.DecodeString(tpl.Content)
+		if err != nil {
+			return RenderedFile{}, fmt.Errorf("binary içerik çözülemedi: %v", err)
+		}
+		content = string(data)
+	} else {
+		rendered, err := render.Render(tpl.Content, ctx)
+		if err != nil {
+			return RenderedFile{}, fmt.Errorf("template render edilemedi: %v", err)
+		}
+		content = rendered
+	}
+
+	filePath, err := RenderPath(tpl.Path, ctx)
+	if err != nil {
+		return RenderedFile{}, err
+	}
+
+	return RenderedFile{Path: filePath, Content: content, Binary: tpl.IsBinary()}, nil
+}
+
+// RenderPath, çıktı yolundaki değişkenleri yerine koyar ve yolu proje köküne göre döndürür
+func RenderPath(templatePath string, ctx *render.Context) (string, error) {
+	rendered, err := render.Render(templatePath, ctx)
+	if err != nil {
+		return "", fmt.Errorf("çıktı yolu render edilemedi: %v", err)
+	}
+	if unresolved := render.UnresolvedValues(rendered, ctx); len(unresolved) > 0 {
+		return "", fmt.Errorf("çıktı yolunda çözülemeyen değişken: %s (%s)", strings.Join(unresolved, ", "), templatePath)
+	}
+
+	rendered = filepath.Clean(filepath.FromSlash(strings.TrimSpace(rendered)))
+	rendered = strings.TrimPrefix(rendered, string(filepath.Separator))
+	if rendered == "." || rendered == "" {
+		return "", fmt.Errorf("çıktı yolu boş: %s", templatePath)
+	}
+	if rendered == ".." || strings.HasPrefix(rendered, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("çıktı yolu proje dışına çıkamaz: %s", rendered)
+	}
+	return rendered, nil
+}
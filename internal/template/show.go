@@ -0,0 +1,110 @@
+package template
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/source"
+)
+
+// FindTemplate, ismi verilen template'in dosya yolunu döndürür.
+// İsim ".json"/".tmpl" uzantısı ile veya uzantısız verilebilir. Uzantısız isimlerde önce JSON aranır.
+// "kaynak:isim" biçimindeki isimler git kaynağının template'leri arasında aranır.
+func FindTemplate(templateName string) (string, error) {
+	sourceName, name := source.Split(templateName)
+	dir, err := utilDir(sourceName)
+	if err != nil {
+		return "", err
+	}
+	templateDir := filepath.Join(dir, "templates")
+
+	// Önce isim uzantısız kabul edilir, böylece config.json gibi dosyaların template'i (config.json.json) bulunur
+	candidates := []string{name + "." + FormatJSON, name + "." + FormatSource}
+	if IsTemplateFile(name) {
+		candidates = append(candidates, name)
+	}
+	for _, candidate := range candidates {
+		templateFile := filepath.Join(templateDir, candidate)
+		if _, err := os.Stat(templateFile); err == nil {
+			return templateFile, nil
+		}
+	}
+	return "", fmt.Errorf("template bulunamadı: %s", templateName)
+}
+
+// PreviewOptions, bir template'in proje dışında render edilmesi için gereken değerleri tutar
+type PreviewOptions struct {
+	ProjectName string
+	// Types, boş verilirse template'in kendi type'ları kullanılır
+	Types []string
+	Vars  map[string]string
+}
+
+// RenderTemplate, ismi verilen template'i soru sormadan render eder.
+// Verilmeyen değişkenler için varsayılan değerler kullanılır.
+func RenderTemplate(templateName string, opts PreviewOptions) ([]RenderedFile, error) {
+	templateFile, err := FindTemplate(templateName)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := LoadTemplate(templateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceName, _ := source.Split(templateName)
+	types := opts.Types
+	if len(types) == 0 {
+		types = tpl.Types
+	} else if sourceName != "" {
+		types = source.LocalTypes(sourceName, types)
+	}
+
+	globals, err := GetVariables()
+	if err != nil {
+		return nil, err
+	}
+	variables, err := MergeVariables(globals, tpl.Variables)
+	if err != nil {
+		return nil, err
+	}
+	values, err := DefaultValues(variables, opts.Vars)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := render.NewContext(opts.ProjectName, types)
+	ctx.LoadPartial = PartialLoader(sourceName)
+	for name, value := range values {
+		ctx.Set(name, value)
+	}
+
+	return RenderFiles(tpl, ctx)
+}
+
+// DefaultValues, verilen değerleri doğrular, eksik değişkenler için varsayılan değerleri kullanır
+func DefaultValues(variables []Variable, provided map[string]string) (map[string]string, error) {
+	values := make(map[string]string)
+	for name, value := range provided {
+		values[name] = value
+	}
+
+	for _, v := range variables {
+		value, ok := provided[v.Name]
+		if !ok {
+			if v.Default == "" {
+				continue
+			}
+			value = v.Default
+		}
+		normalized, err := v.Normalize(value)
+		if err != nil {
+			return nil, err
+		}
+		values[v.Name] = normalized
+	}
+
+	return values, nil
+}
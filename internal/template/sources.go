@@ -0,0 +1,49 @@
+package template
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/burak/flutter_assist/internal/render"
+	"github.com/burak/flutter_assist/internal/source"
+)
+
+// utilDir, template'lerin okunacağı template_util klasörünü döndürür.
+// Kaynak ismi verilirse git kaynağının önbellekteki kökü döner.
+func utilDir(sourceName string) (string, error) {
+	if sourceName != "" {
+		return source.Root(sourceName)
+	}
+
+	execPath, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
+	}
+	return filepath.Join(filepath.Dir(execPath), "template_util"), nil
+}
+
+// PartialLoader, template'in kaynağına göre partial yükleyicisi döndürür.
+// Kaynak template'lerinde partial'lar önce kaynağın kendi partials klasöründe, sonra genel klasörde aranır.
+func PartialLoader(sourceName string) render.PartialLoader {
+	if sourceName == "" {
+		return GetPartial
+	}
+	return func(name string) (string, error) {
+		if owner, _ := source.Split(name); owner != "" {
+			return GetPartial(name)
+		}
+		if content, err := GetPartial(source.Qualify(sourceName, name)); err == nil {
+			return content, nil
+		}
+		return GetPartial(name)
+	}
+}
+
+// checkWritable, git kaynaklarına ait template'lerin yerelde değiştirilmesini engeller
+func checkWritable(templateName string) error {
+	if sourceName, _ := source.Split(templateName); sourceName != "" {
+		return fmt.Errorf("%s kaynağının template'leri değiştirilemez, kaynak deposunda düzenleyip 'source update' çalıştırın", sourceName)
+	}
+	return nil
+}
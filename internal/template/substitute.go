@@ -0,0 +1,191 @@
+package template
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/prompt"
+	"github.com/burak/flutter_assist/internal/render"
+)
+
+// replacement, içerikte proje ismi yerine anahtar kelime konulacak bir aralığı tutar
+type replacement struct {
+	start, end int
+	key        string
+	kind       string
+}
+
+// substitutionSession, bir yakalama işlemi boyunca kullanıcının toplu kararlarını tutar
+type substitutionSession struct {
+	projectName string
+	acceptAll   bool
+	rejectAll   bool
+}
+
+// newSubstitutionSession, verilen proje ismi için yeni bir oturum oluşturur.
+// acceptAll true ise tüm güvenli değişiklikler sorulmadan uygulanır.
+func newSubstitutionSession(projectName string, acceptAll bool) *substitutionSession {
+	return &substitutionSession{projectName: projectName, acceptAll: acceptAll}
+}
+
+// apply, içerikteki proje ismi geçişlerini kullanıcı onayı ile anahtar kelimelere çevirir
+func (s *substitutionSession) apply(filePath string, content string) string {
+	replacements := findReplacements(content, s.projectName)
+	if len(replacements) == 0 {
+		return content
+	}
+
+	var accepted []replacement
+	for i, r := range replacements {
+		if s.rejectAll {
+			break
+		}
+		if s.acceptAll {
+			accepted = append(accepted, r)
+			continue
+		}
+
+		fmt.Printf("\n🔍 %s (%d/%d) - %s\n", filePath, i+1, len(replacements), r.kind)
+		printReplacementContext(content, r)
+
+		switch askReplacement() {
+		case "e":
+			accepted = append(accepted, r)
+		case "t":
+			s.acceptAll = true
+			accepted = append(accepted, r)
+		case "r":
+			s.rejectAll = true
+		}
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, r := range accepted {
+		b.WriteString(content[last:r.start])
+		b.WriteString(r.key)
+		last = r.end
+	}
+	b.WriteString(content[last:])
+	return b.String()
+}
+
+// findReplacements, proje isminin ve isim dönüşümlerinin sadece güvenli bağlamlardaki geçişlerini bulur.
+// package: importları, pubspec name alanı ve tam kelime eşleşmeleri güvenli kabul edilir;
+// "AppCache" veya "mapper" gibi bir kelimenin parçası olan geçişler atlanır.
+func findReplacements(content string, projectName string) []replacement {
+	if projectName == "" {
+		return nil
+	}
+
+	// Proje isminin kendisi ve farklı yazılışları
+	variants := []struct{ value, key string }{{projectName, render.ProjectKey}}
+	for _, mode := range []string{"pascal", "camel"} {
+		converted, _ := render.ConvertCase(projectName, mode)
+		if converted != "" && converted != projectName {
+			variants = append(variants, struct{ value, key string }{converted, "{FLUTTER_ASSIST:" + mode + "}"})
+		}
+	}
+
+	taken := make([]bool, len(content))
+	var replacements []replacement
+	for _, variant := range variants {
+		offset := 0
+		for {
+			idx := strings.Index(content[offset:], variant.value)
+			if idx == -1 {
+				break
+			}
+			start := offset + idx
+			end := start + len(variant.value)
+			offset = end
+
+			if !isWordBoundary(content, start, end) || taken[start] {
+				continue
+			}
+			for i := start; i < end; i++ {
+				taken[i] = true
+			}
+			replacements = append(replacements, replacement{
+				start: start,
+				end:   end,
+				key:   variant.key,
+				kind:  replacementKind(content, start, end),
+			})
+		}
+	}
+
+	sort.Slice(replacements, func(i, j int) bool {
+		return replacements[i].start < replacements[j].start
+	})
+	return replacements
+}
+
+// replacementKind, geçişin hangi bağlamda bulunduğunu açıklar
+func replacementKind(content string, start, end int) string {
+	lineStart := strings.LastIndex(content[:start], "\n") + 1
+	prefix := strings.TrimSpace(content[lineStart:start])
+
+	switch {
+	case strings.HasSuffix(content[:start], "package:") && strings.HasPrefix(content[end:], "/"):
+		return "package import"
+	case prefix == "name:":
+		return "pubspec name"
+	default:
+		return "tam kelime"
+	}
+}
+
+// isWordBoundary, aralığın önünde ve arkasında tanımlayıcı karakteri olmadığını kontrol eder
+func isWordBoundary(content string, start, end int) bool {
+	if start > 0 && isIdentifierChar(content[start-1]) {
+		return false
+	}
+	if end < len(content) && isIdentifierChar(content[end]) {
+		return false
+	}
+	return true
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+// printReplacementContext, değişikliği satır numarası ve önceki/sonraki satırlar ile gösterir
+func printReplacementContext(content string, r replacement) {
+	lines := strings.Split(content, "\n")
+	lineIndex := strings.Count(content[:r.start], "\n")
+	lineStart := strings.LastIndex(content[:r.start], "\n") + 1
+
+	for i := lineIndex - 1; i <= lineIndex+1; i++ {
+		if i < 0 || i >= len(lines) {
+			continue
+		}
+		line := lines[i]
+		marker := " "
+		if i == lineIndex {
+			marker = ">"
+			col := r.start - lineStart
+			line = line[:col] + "»" + line[col:col+r.end-r.start] + "«" + line[col+r.end-r.start:]
+		}
+		fmt.Printf("  %s %4d | %s\n", marker, i+1, line)
+	}
+	fmt.Printf("  ➡️ %s\n", r.key)
+}
+
+// askReplacement, kullanıcıdan değişiklik için karar ister
+func askReplacement() string {
+	for {
+		fmt.Print("ℹ️ Değiştirilsin mi? (e)vet / (h)ayır / (t)ümünü kabul et / (r)eddet kalanları: ")
+		input, err := prompt.ReadLine()
+		input = strings.ToLower(input)
+		switch input {
+		case "e", "h", "t", "r":
+			return input
+		}
+		if err != nil {
+			return "h"
+		}
+	}
+}
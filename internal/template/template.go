@@ -1,22 +1,68 @@
 package template
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/burak/flutter_assist/internal/prompt"
+	"github.com/burak/flutter_assist/internal/pubspec"
+	"github.com/burak/flutter_assist/internal/source"
 )
 
 // Template yapısı
 type Template struct {
-	Path    string   `json:"path"`
-	Content string   `json:"content"`
-	Types   []string `json:"types"`
+	Path      string     `json:"path"`
+	Content   string     `json:"content"`
+	Types     []string   `json:"types"`
+	Variables []Variable `json:"variables,omitempty"`
+	// ForEach, bir liste değişkeninin ismidir. Verilirse her eleman için ayrı dosya üretilir
+	ForEach string `json:"foreach,omitempty"`
+	// Encoding, içerik binary bir dosyaya aitse "base64" olur
+	Encoding string `json:"encoding,omitempty"`
+	// Asset, dosyanın klasörü proje oluşturulurken pubspec.yaml assets listesine eklenir
+	Asset bool `json:"asset,omitempty"`
+	// Test, dosyanın companion testinin içeriğidir. Verilirse lib/ altındaki dosya için test/ altında
+	// aynı yolda _test.dart ile biten test dosyası da üretilir
+	Test string `json:"test,omitempty"`
+}
+
+// EncodingBase64, binary dosyaların template içinde saklanma biçimidir
+const EncodingBase64 = "base64"
+
+// IsBinary, template'in binary bir dosyaya ait olup olmadığını döndürür
+func (t *Template) IsBinary() bool {
+	return t.Encoding == EncodingBase64
+}
+
+// CaptureOptions, template yakalama sırasında kullanılacak ek seçenekleri tutar
+type CaptureOptions struct {
+	// Include, verilirse sadece bu glob'lara uyan dosyalar yakalanır
+	Include []string
+	// Exclude, bu glob'lara uyan dosya ve klasörler atlanır
+	Exclude []string
+	// AcceptAll, proje ismi değişikliklerini tek tek sormadan kabul eder
+	AcceptAll bool
+	// Format, yakalanan template'lerin kaydedileceği formattır (json veya tmpl). Boşsa json kullanılır
+	Format string
 }
 
 // CreateTemplate, yeni bir template oluşturur
-func CreateTemplate(templatePath string, types []string) error {
+func CreateTemplate(templatePath string, types []string, opts CaptureOptions) error {
+	switch opts.Format {
+	case "":
+		opts.Format = FormatJSON
+	case FormatJSON, FormatSource:
+	default:
+		return fmt.Errorf("geçersiz format: %s (json veya tmpl olmalı)", opts.Format)
+	}
+
 	execPath, err := os.Executable()
 	if err != nil {
 		return fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
@@ -25,10 +71,12 @@ func CreateTemplate(templatePath string, types []string) error {
 	execDir := filepath.Dir(execPath)
 	templateDir := filepath.Join(execDir, "template_util", "templates")
 
-	// Proje ismini al
-	fmt.Print("📝 Proje ismini girin: ")
-	var projectName string
-	fmt.Scanln(&projectName)
+	// Yolu içeren Flutter projesini bul, bulunamazsa proje ismini kullanıcıdan al
+	projectName, projectRoot := detectProject(templatePath)
+	if projectName == "" {
+		fmt.Print("📝 Proje ismini girin: ")
+		projectName, _ = prompt.ReadLine()
+	}
 
 	// Template util klasörünü oluştur
 	if err := os.MkdirAll(templateDir, 0755); err != nil {
@@ -41,55 +89,123 @@ func CreateTemplate(templatePath string, types []string) error {
 		return fmt.Errorf("template yolu okunamadı: %v", err)
 	}
 
+	// Proje ismi değişiklikleri önizlenip onaylanarak uygulanır
+	session := newSubstitutionSession(projectName, opts.AcceptAll)
+
 	if info.IsDir() {
 		// Klasör ise içindeki tüm dosyaları işle
-		return processDirectory(templatePath, types, templateDir, projectName)
+		return processDirectory(templatePath, types, templateDir, projectRoot, session, opts)
 	} else {
 		// Dosya ise tek dosyayı işle
-		return processFile(templatePath, types, templateDir, projectName)
+		return processFile(templatePath, filepath.Base(templatePath), types, templateDir, projectRoot, session, opts.Format)
 	}
 }
 
+// detectProject, yolu içeren Flutter projesinin ismini ve kök klasörünü döndürür.
+// Proje bulunamazsa boş değerler döner.
+func detectProject(templatePath string) (string, string) {
+	root, err := pubspec.FindRoot(templatePath)
+	if err != nil {
+		return "", ""
+	}
+
+	name, err := pubspec.Name(filepath.Join(root, pubspec.FileName))
+	if err != nil {
+		fmt.Printf("⚠️ %s: %v\n", root, err)
+		return "", ""
+	}
+
+	fmt.Printf("📦 Flutter projesi bulundu: %s (%s)\n", name, root)
+	return name, root
+}
+
+// templatePathFor, dosyanın template içinde saklanacak yolunu döndürür.
+// Proje kökü biliniyorsa yol köke göre hesaplanır.
+func templatePathFor(filePath string, projectRoot string) (string, error) {
+	if projectRoot == "" {
+		return strings.TrimPrefix(filePath, "/"), nil
+	}
+
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return "", fmt.Errorf("yol çözülemedi: %v", err)
+	}
+	relPath, err := filepath.Rel(projectRoot, absPath)
+	if err != nil {
+		return "", fmt.Errorf("proje köküne göre yol hesaplanamadı: %v", err)
+	}
+	return filepath.ToSlash(relPath), nil
+}
+
 // GetTemplate, belirtilen template'i döndürür
 func GetTemplate(templateName string) (string, error) {
-	execPath, err := os.Executable()
+	templateFile, err := FindTemplate(templateName)
 	if err != nil {
-		return "", fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
+		return "", err
 	}
 
-	execDir := filepath.Dir(execPath)
-	templateDir := filepath.Join(execDir, "template_util", "templates")
+	template, err := LoadTemplate(templateFile)
+	if err != nil {
+		return "", err
+	}
+
+	return template.Content, nil
+}
 
-	// Template dosyasını oku
-	templateFile := filepath.Join(templateDir, templateName+".json")
-	data, err := os.ReadFile(templateFile)
+// GetPartial, template_util/partials klasöründeki partial'ın içeriğini döndürür.
+// "kaynak:isim" biçimindeki isimler kaynağın partials klasöründen okunur.
+func GetPartial(partialName string) (string, error) {
+	sourceName, name := source.Split(partialName)
+	dir, err := utilDir(sourceName)
 	if err != nil {
-		return "", fmt.Errorf("template dosyası okunamadı: %v", err)
+		return "", err
 	}
+	partialDir := filepath.Join(dir, "partials")
 
-	// Template yapısını parse et
-	var template struct {
+	// Partial dosyasını oku. Kaynak formatındaki partial'lar öncelikli, front-matter isteğe bağlıdır
+	partialFile := filepath.Join(partialDir, filepath.FromSlash(name))
+	if data, err := os.ReadFile(partialFile + "." + FormatSource); err == nil {
+		if !strings.HasPrefix(string(data), frontMatterDelimiter) {
+			return string(data), nil
+		}
+		var partial Template
+		if err := parseSource(data, &partial); err != nil {
+			return "", fmt.Errorf("partial front-matter hatası %s: %v", partialName, err)
+		}
+		return partial.Content, nil
+	}
+
+	partialFile += "." + FormatJSON
+	data, err := os.ReadFile(partialFile)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("partial bulunamadı: %s (%s)", partialName, partialFile)
+	}
+	if err != nil {
+		return "", fmt.Errorf("partial dosyası okunamadı: %v", err)
+	}
+
+	// Partial yapısını parse et
+	var partial struct {
 		Content string `json:"content"`
 	}
-	if err := json.Unmarshal(data, &template); err != nil {
-		return "", fmt.Errorf("template JSON parse hatası: %v", err)
+	if err := json.Unmarshal(data, &partial); err != nil {
+		return "", fmt.Errorf("partial JSON parse hatası %s: %v", partialName, err)
 	}
 
-	return template.Content, nil
+	return partial.Content, nil
 }
 
 // DeleteTemplate, belirtilen template'i siler
 func DeleteTemplate(templateName string) error {
-	execPath, err := os.Executable()
+	if err := checkWritable(templateName); err != nil {
+		return err
+	}
+	templateFile, err := FindTemplate(templateName)
 	if err != nil {
-		return fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
+		return err
 	}
 
-	execDir := filepath.Dir(execPath)
-	templateDir := filepath.Join(execDir, "template_util", "templates")
-
 	// Template dosyasını sil
-	templateFile := filepath.Join(templateDir, templateName+".json")
 	if err := os.Remove(templateFile); err != nil {
 		return fmt.Errorf("template dosyası silinemedi: %v", err)
 	}
@@ -99,34 +215,25 @@ func DeleteTemplate(templateName string) error {
 
 // GetTemplateTypes, template'in type'larını döndürür
 func GetTemplateTypes(templateName string) ([]string, error) {
-	execPath, err := os.Executable()
+	templateFile, err := FindTemplate(templateName)
 	if err != nil {
-		return nil, fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
+		return nil, err
 	}
 
-	execDir := filepath.Dir(execPath)
-	templateDir := filepath.Join(execDir, "template_util", "templates")
-
-	// Template dosyasını oku
-	templateFile := filepath.Join(templateDir, templateName+".json")
-	data, err := os.ReadFile(templateFile)
+	template, err := LoadTemplate(templateFile)
 	if err != nil {
-		return nil, fmt.Errorf("template dosyası okunamadı: %v", err)
-	}
-
-	// Template yapısını parse et
-	var template struct {
-		Types []string `json:"types"`
-	}
-	if err := json.Unmarshal(data, &template); err != nil {
-		return nil, fmt.Errorf("template JSON parse hatası: %v", err)
+		return nil, err
 	}
 
 	return template.Types, nil
 }
 
-// UpdateTemplate, template'i günceller
+// UpdateTemplate, template'in içeriğini ve type'larını günceller.
+// Mevcut template'in path, değişken ve diğer alanları ile dosya formatı korunur.
 func UpdateTemplate(templateName string, content string, types []string) error {
+	if err := checkWritable(templateName); err != nil {
+		return err
+	}
 	execPath, err := os.Executable()
 	if err != nil {
 		return fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
@@ -134,81 +241,178 @@ func UpdateTemplate(templateName string, content string, types []string) error {
 
 	execDir := filepath.Dir(execPath)
 	templateDir := filepath.Join(execDir, "template_util", "templates")
+	templateFile := filepath.Join(templateDir, TemplateName(templateName)+"."+FormatJSON)
 
-	// Template yapısını oluştur
-	template := struct {
-		Path    string   `json:"path"`
-		Content string   `json:"content"`
-		Types   []string `json:"types"`
-	}{
-		Path:    templateName,
-		Content: content,
-		Types:   types,
-	}
-
-	// JSON'a dönüştür
-	jsonData, err := json.MarshalIndent(template, "", "  ")
-	if err != nil {
-		return fmt.Errorf("JSON dönüştürme hatası: %v", err)
-	}
-
-	// Template dosyasını kaydet
-	templateFile := filepath.Join(templateDir, templateName+".json")
-	if err := os.WriteFile(templateFile, jsonData, 0644); err != nil {
-		return fmt.Errorf("template dosyası kaydedilemedi: %v", err)
+	// Mevcut template varsa alanlarını koru
+	template := &Template{Path: TemplateName(templateName)}
+	if existing, err := FindTemplate(templateName); err == nil {
+		templateFile = existing
+		template, err = LoadTemplate(templateFile)
+		if err != nil {
+			return err
+		}
 	}
+	template.Content = content
+	template.Types = types
 
-	return nil
+	return SaveTemplate(templateFile, template)
 }
 
-// processFile, tek bir dosyayı template'e dönüştürür
-func processFile(filePath string, types []string, templateDir string, projectName string) error {
+// processFile, tek bir dosyayı verilen isimle template'e dönüştürür
+func processFile(filePath string, name string, types []string, templateDir string, projectRoot string, session *substitutionSession, format string) error {
 	// Dosya içeriğini oku
 	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return fmt.Errorf("dosya okunamadı: %v", err)
 	}
 
-	// İçerikteki proje ismini {FLUTTER_ASSIST} ile değiştir
-	contentStr := strings.ReplaceAll(string(content), projectName, "{FLUTTER_ASSIST}")
+	templatePath, err := templatePathFor(filePath, projectRoot)
+	if err != nil {
+		return err
+	}
 
-	// Template oluştur
-	template := struct {
-		Path    string   `json:"path"`
-		Content string   `json:"content"`
-		Types   []string `json:"types"`
-	}{
-		Path:    strings.TrimPrefix(filePath, "/"),
-		Content: contentStr,
-		Types:   types,
+	template := Template{
+		Path:  templatePath,
+		Types: types,
+		// assets altındaki dosyalar (görsel, lottie, svg, çeviri JSON) pubspec.yaml'a kaydedilir
+		Asset: isAssetPath(templatePath),
 	}
 
-	// JSON'a dönüştür
-	jsonData, err := json.MarshalIndent(template, "", "  ")
-	if err != nil {
-		return fmt.Errorf("JSON dönüştürme hatası: %v", err)
+	if isBinary(content) {
+		// Binary dosyalar olduğu gibi base64 olarak saklanır
+		template.Content = base64.StdEncoding.EncodeToString(content)
+		template.Encoding = EncodingBase64
+	} else {
+		// İçerikteki proje ismini güvenli bağlamlarda {FLUTTER_ASSIST} ile değiştir
+		template.Content = session.apply(filePath, string(content))
 	}
 
-	// Template dosyasını kaydet
-	fileName := filepath.Base(filePath) + ".json"
-	outputPath := filepath.Join(templateDir, fileName)
-	if err := os.WriteFile(outputPath, jsonData, 0644); err != nil {
-		return fmt.Errorf("template dosyası kaydedilemedi: %v", err)
+	// Template dosyasını seçilen formatta kaydet
+	if err := SaveTemplate(filepath.Join(templateDir, name+"."+format), &template); err != nil {
+		return err
 	}
 
+	// Diğer formattaki eski template silinir, aksi halde iki template aynı dosyayı üretir
+	other := FormatSource
+	if format == FormatSource {
+		other = FormatJSON
+	}
+	oldFile := filepath.Join(templateDir, name+"."+other)
+	if _, err := os.Stat(oldFile); err == nil {
+		if err := os.Remove(oldFile); err != nil {
+			return fmt.Errorf("eski template dosyası silinemedi: %v", err)
+		}
+		fmt.Printf("  🔄 %s silindi, template %s formatında kaydedildi\n", filepath.Base(oldFile), format)
+	}
 	return nil
 }
 
-// processDirectory, bir klasörü ve içindeki tüm dosyaları template'e dönüştürür
-func processDirectory(dirPath string, types []string, templateDir string, projectName string) error {
-	return filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+// isBinary, içeriğin metin olarak saklanamayacak bir dosyaya ait olup olmadığını döndürür
+func isBinary(content []byte) bool {
+	sample := content
+	if len(sample) > 8000 {
+		sample = sample[:8000]
+	}
+	return bytes.IndexByte(sample, 0) != -1 || !utf8.Valid(content)
+}
+
+// isAssetPath, proje köküne göre yolu verilen dosyanın kökteki assets klasörü altında olup olmadığını döndürür
+func isAssetPath(templatePath string) bool {
+	first, _, _ := strings.Cut(path.Clean(templatePath), "/")
+	return first == "assets"
+}
+
+// processDirectory, bir klasörü ve içindeki tüm dosyaları template'e dönüştürür.
+// Varsayılan ignore kuralları, .flutterassistignore dosyası ve include/exclude glob'ları uygulanır.
+func processDirectory(dirPath string, types []string, templateDir string, projectRoot string, session *substitutionSession, opts CaptureOptions) error {
+	filter, err := newFileFilter(dirPath, opts.Include, opts.Exclude)
+	if err != nil {
+		return err
+	}
+
+	var files []string
+	var captured []string
+	var skipped []string
+	err = filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
+		relPath, err := filepath.Rel(dirPath, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if reason := filter.skipReason(relPath, info.IsDir()); reason != "" {
+			if info.IsDir() {
+				skipped = append(skipped, fmt.Sprintf("%s/ (%s)", relPath, reason))
+				return filepath.SkipDir
+			}
+			skipped = append(skipped, fmt.Sprintf("%s (%s)", relPath, reason))
+			return nil
+		}
+
 		if !info.IsDir() {
-			return processFile(path, types, templateDir, projectName)
+			files = append(files, path)
+			captured = append(captured, relPath)
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// Template isimleri dosya isminden, çakışanlar klasöre göre yoldan üretilir; hiçbir dosya yazılmadan kontrol edilir
+	names, err := templateNames(captured)
+	if err != nil {
+		return err
+	}
+	for i, path := range files {
+		if err := processFile(path, names[i], types, templateDir, projectRoot, session, opts.Format); err != nil {
+			return err
+		}
+	}
+
+	// Yakalanan ve atlanan dosyaların özetini göster
+	fmt.Printf("📊 %d dosya yakalandı, %d dosya/klasör atlandı\n", len(captured), len(skipped))
+	for i, path := range captured {
+		if names[i] != filepath.Base(path) {
+			fmt.Printf("  ✅ %s (%s)\n", path, names[i])
+		} else {
+			fmt.Printf("  ✅ %s\n", path)
+		}
+	}
+	for _, path := range skipped {
+		fmt.Printf("  ⏭️ %s\n", path)
+	}
+
+	return nil
+}
+
+// templateNames, yakalanan dosyaların template isimlerini döndürür. İsim dosya ismidir; aynı isimli
+// dosyalar varsa bunların isimleri klasöre göre yoldan üretilir: a/view.dart, b/view.dart -> a_view.dart, b_view.dart
+func templateNames(relPaths []string) ([]string, error) {
+	counts := make(map[string]int)
+	for _, relPath := range relPaths {
+		counts[path.Base(relPath)]++
+	}
+
+	names := make([]string, len(relPaths))
+	owners := make(map[string]string)
+	for i, relPath := range relPaths {
+		name := path.Base(relPath)
+		if counts[name] > 1 {
+			name = strings.ReplaceAll(relPath, "/", "_")
+		}
+		if owner, ok := owners[name]; ok {
+			return nil, fmt.Errorf("%s ve %s aynı template ismini (%s) kullanıyor, klasörleri ayrı yakalayın", owner, relPath, name)
+		}
+		owners[name] = relPath
+		names[i] = name
+	}
+	return names, nil
 }
@@ -0,0 +1,262 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/burak/flutter_assist/internal/prompt"
+	"github.com/burak/flutter_assist/internal/render"
+)
+
+// Variable, template'lerde kullanılabilecek kullanıcı tanımlı bir değişkeni tanımlar
+type Variable struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Regex       string `json:"regex,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Desteklenen değişken tipleri
+const (
+	VariableString = "string"
+	VariableInt    = "int"
+	VariableBool   = "bool"
+	VariableList   = "list"
+)
+
+var variableName = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// Check, değişken tanımının geçerli olup olmadığını kontrol eder
+func (v Variable) Check() error {
+	if !variableName.MatchString(v.Name) {
+		return fmt.Errorf("geçersiz değişken ismi: %q (büyük harf, rakam ve _ kullanılmalı)", v.Name)
+	}
+	for _, reserved := range render.ReservedNames {
+		if v.Name == reserved {
+			return fmt.Errorf("değişken ismi ayrılmış bir anahtar kelime: %s", v.Name)
+		}
+	}
+	switch v.Type {
+	case "", VariableString, VariableInt, VariableBool, VariableList:
+	default:
+		return fmt.Errorf("%s değişkeni için geçersiz tip: %s", v.Name, v.Type)
+	}
+	if v.Regex != "" {
+		if _, err := regexp.Compile(v.Regex); err != nil {
+			return fmt.Errorf("%s değişkeni için geçersiz regex: %v", v.Name, err)
+		}
+	}
+	return nil
+}
+
+// Normalize, değeri değişkenin tipine göre doğrular ve normalize edilmiş halini döndürür
+func (v Variable) Normalize(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch v.Type {
+	case VariableInt:
+		if _, err := strconv.Atoi(value); err != nil {
+			return "", fmt.Errorf("%s bir tam sayı olmalı: %q", v.Name, value)
+		}
+	case VariableBool:
+		switch strings.ToLower(value) {
+		case "true", "yes", "y", "evet", "e", "1":
+			value = "true"
+		case "false", "no", "n", "hayır", "h", "0":
+			value = "false"
+		default:
+			return "", fmt.Errorf("%s true veya false olmalı: %q", v.Name, value)
+		}
+	case VariableList:
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		value = strings.Join(items, ",")
+	}
+
+	if v.Regex != "" {
+		re := regexp.MustCompile(v.Regex)
+		if v.Type == VariableList {
+			for _, item := range strings.Split(value, ",") {
+				if !re.MatchString(item) {
+					return "", fmt.Errorf("%s değeri %s ifadesine uymuyor: %q", v.Name, v.Regex, item)
+				}
+			}
+		} else if !re.MatchString(value) {
+			return "", fmt.Errorf("%s değeri %s ifadesine uymuyor: %q", v.Name, v.Regex, value)
+		}
+	}
+
+	return value, nil
+}
+
+// GetVariables, template_util/variables.json dosyasındaki genel değişken tanımlarını döndürür
+func GetVariables() ([]Variable, error) {
+	execPath, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("çalıştırılabilir dosya yolu alınamadı: %v", err)
+	}
+
+	execDir := filepath.Dir(execPath)
+	variablesPath := filepath.Join(execDir, "template_util", "variables.json")
+
+	// Dosya yoksa boş liste döndür
+	if _, err := os.Stat(variablesPath); os.IsNotExist(err) {
+		return []Variable{}, nil
+	}
+
+	data, err := os.ReadFile(variablesPath)
+	if err != nil {
+		return nil, fmt.Errorf("değişkenler dosyası okunamadı: %v", err)
+	}
+
+	var result struct {
+		Variables []Variable `json:"variables"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("değişkenler JSON parse hatası: %v", err)
+	}
+
+	return result.Variables, nil
+}
+
+// ReadValuesFile, --values ile verilen JSON dosyasındaki değişken değerlerini okur
+func ReadValuesFile(valuesPath string) (map[string]string, error) {
+	data, err := os.ReadFile(valuesPath)
+	if err != nil {
+		return nil, fmt.Errorf("değerler dosyası okunamadı: %v", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("değerler JSON parse hatası: %v", err)
+	}
+
+	return StringValues(raw), nil
+}
+
+// StringValues, JSON'dan okunan değerleri değişken değerlerine çevirir. Listeler virgülle birleştirilir
+func StringValues(raw map[string]interface{}) map[string]string {
+	values := make(map[string]string)
+	for key, value := range raw {
+		switch v := value.(type) {
+		case []interface{}:
+			items := make([]string, len(v))
+			for i, item := range v {
+				items[i] = fmt.Sprint(item)
+			}
+			values[key] = strings.Join(items, ",")
+		case nil:
+			values[key] = ""
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+	return values
+}
+
+// MergeVariables, aynı isimli değişken tanımlarını birleştirir. İlk tanım geçerli olur
+func MergeVariables(groups ...[]Variable) ([]Variable, error) {
+	seen := make(map[string]bool)
+	var merged []Variable
+	for _, group := range groups {
+		for _, v := range group {
+			if err := v.Check(); err != nil {
+				return nil, err
+			}
+			if seen[v.Name] {
+				continue
+			}
+			seen[v.Name] = true
+			merged = append(merged, v)
+		}
+	}
+	return merged, nil
+}
+
+// ResolveVariables, tanımlı değişkenlerin değerlerini toplar.
+// Verilen değerler önceliklidir, eksik olanlar kullanıcıya sorulur.
+func ResolveVariables(variables []Variable, provided map[string]string) (map[string]string, error) {
+	declared := make(map[string]bool)
+	values := make(map[string]string)
+
+	for _, v := range variables {
+		declared[v.Name] = true
+
+		if value, ok := provided[v.Name]; ok {
+			normalized, err := v.Normalize(value)
+			if err != nil {
+				return nil, err
+			}
+			values[v.Name] = normalized
+			continue
+		}
+
+		value, err := promptVariable(v)
+		if err != nil {
+			return nil, err
+		}
+		values[v.Name] = value
+	}
+
+	// Tanımlı olmayan değerler de template'lerde kullanılabilsin
+	var extra []string
+	for name := range provided {
+		if !declared[name] {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		if !variableName.MatchString(name) {
+			return nil, fmt.Errorf("geçersiz değişken ismi: %q", name)
+		}
+		values[name] = provided[name]
+	}
+
+	return values, nil
+}
+
+// promptVariable, değişkenin değerini kullanıcıdan ister ve geçerli bir değer girilene kadar tekrar sorar
+func promptVariable(v Variable) (string, error) {
+	for {
+		label := v.Name
+		if v.Description != "" {
+			label = fmt.Sprintf("%s (%s)", v.Description, v.Name)
+		}
+		if v.Default != "" {
+			fmt.Printf("📝 %s [%s]: ", label, v.Default)
+		} else {
+			fmt.Printf("📝 %s: ", label)
+		}
+
+		input, err := prompt.ReadLine()
+		if err != nil {
+			if v.Default != "" {
+				return v.Normalize(v.Default)
+			}
+			return "", fmt.Errorf("%s değişkeni için değer okunamadı: %v", v.Name, err)
+		}
+
+		if input == "" {
+			input = v.Default
+		}
+
+		value, err := v.Normalize(input)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			continue
+		}
+		return value, nil
+	}
+}